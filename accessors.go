@@ -0,0 +1,307 @@
+package xal
+
+// PostalCode returns the postal code of the address, wherever it is stored, or "" if there is none.
+//
+// Postal codes are looked up on the locality first, then on the thoroughfare, the premise,
+// the post box and the post office.
+func (a *AddressDetails) PostalCode() string {
+	return a.postalCode().number()
+}
+
+// LocalityName returns the first name of the locality, eg. the city, or "" if there is none.
+func (a *AddressDetails) LocalityName() string {
+	return a.locality().name()
+}
+
+// StreetName returns the first name of the thoroughfare, without its leading or trailing type, or "" if there is none.
+func (a *AddressDetails) StreetName() string {
+	return a.thoroughfare().name()
+}
+
+// HouseNumber returns the premise number with its suffix, eg. 12A, falling back to the thoroughfare number.
+// It returns "" if there is neither.
+func (a *AddressDetails) HouseNumber() string {
+	if n := a.premise().number(); n != "" {
+		return n
+	}
+	return a.thoroughfare().number()
+}
+
+// CountryCode returns the country code of the address, eg. US, or "" if there is none.
+func (a *AddressDetails) CountryCode() string {
+	if a == nil {
+		return ""
+	}
+	return a.Country.code()
+}
+
+// AdminArea returns the first name of the administrative area, eg. CA, or "" if there is none.
+func (a *AddressDetails) AdminArea() string {
+	return a.administrativeArea().name()
+}
+
+// Branch - The branch of the tree in which setters create the nodes that are missing.
+//
+// The spec only allows one of Country, AdministrativeArea and Locality directly under AddressDetails,
+// and one of AdministrativeArea and Locality under Country. Setters respect that: setting a country code
+// always moves the top level administrative area or locality under the country.
+type Branch int
+
+const (
+	// BranchCountry creates missing nodes under AddressDetails/Country. It is the default branch.
+	BranchCountry Branch = iota
+	// BranchAdministrativeArea creates missing nodes under AddressDetails/AdministrativeArea.
+	BranchAdministrativeArea
+	// BranchLocality creates missing nodes under AddressDetails/Locality.
+	BranchLocality
+)
+
+// Setter - Sets the key components of an address, updating existing nodes in place
+// and creating the missing ones in its branch.
+//
+// Setting an empty value removes the node holding the component; intermediate nodes are kept.
+type Setter struct {
+	a      *AddressDetails
+	branch Branch
+}
+
+// In returns a Setter that creates missing nodes of a in branch b.
+func (a *AddressDetails) In(b Branch) Setter {
+	return Setter{a: a, branch: b}
+}
+
+// SetPostalCode sets the postal code, creating it on the locality if there is none, see Setter.
+func (a *AddressDetails) SetPostalCode(v string) { a.In(BranchCountry).SetPostalCode(v) }
+
+// SetLocalityName sets the name of the locality, see Setter.
+func (a *AddressDetails) SetLocalityName(v string) { a.In(BranchCountry).SetLocalityName(v) }
+
+// SetStreetName sets the name of the thoroughfare, see Setter.
+func (a *AddressDetails) SetStreetName(v string) { a.In(BranchCountry).SetStreetName(v) }
+
+// SetHouseNumber sets the house number, see Setter.
+func (a *AddressDetails) SetHouseNumber(v string) { a.In(BranchCountry).SetHouseNumber(v) }
+
+// SetCountryCode sets the country code, see Setter.
+func (a *AddressDetails) SetCountryCode(v string) { a.In(BranchCountry).SetCountryCode(v) }
+
+// SetAdminArea sets the name of the administrative area, see Setter.
+func (a *AddressDetails) SetAdminArea(v string) { a.In(BranchCountry).SetAdminArea(v) }
+
+// SetPostalCode sets the postal code, creating it on the locality if there is none.
+func (s Setter) SetPostalCode(v string) {
+	pc := s.a.postalCode()
+	if v == "" {
+		if pc != nil {
+			pc.PostalCodeNumber = nil
+		}
+		return
+	}
+	pc = s.postalCode()
+	if len(pc.PostalCodeNumber) == 0 {
+		pc.PostalCodeNumber = []*PostalCodeNumber{{}}
+	}
+	pc.PostalCodeNumber[0].Text = v
+}
+
+// SetLocalityName sets the first name of the locality.
+func (s Setter) SetLocalityName(v string) {
+	l := s.a.locality()
+	if v == "" {
+		if l != nil && len(l.LocalityName) > 0 {
+			l.LocalityName = l.LocalityName[1:]
+		}
+		return
+	}
+	if l == nil {
+		l = s.locality()
+	}
+	if len(l.LocalityName) == 0 {
+		l.LocalityName = []*LocalityName{{}}
+	}
+	l.LocalityName[0].Text = v
+}
+
+// SetStreetName sets the first name of the thoroughfare, creating the thoroughfare on the locality if there is none.
+func (s Setter) SetStreetName(v string) {
+	t := s.a.thoroughfare()
+	if v == "" {
+		if t != nil && len(t.ThoroughfareName) > 0 {
+			t.ThoroughfareName = t.ThoroughfareName[1:]
+		}
+		return
+	}
+	if t == nil {
+		t = s.thoroughfare()
+	}
+	if len(t.ThoroughfareName) == 0 {
+		t.ThoroughfareName = []*ThoroughfareName{{}}
+	}
+	t.ThoroughfareName[0].Text = v
+}
+
+// SetHouseNumber sets the house number, including its suffix if any, eg. 12A.
+//
+// The number is stored on the premise, unless the address only carries a thoroughfare number,
+// in which case that number is updated.
+func (s Setter) SetHouseNumber(v string) {
+	if t := s.a.thoroughfare(); s.a.premise() == nil && t != nil && len(t.ThoroughfareNumber) > 0 {
+		t.ThoroughfareNumberSuffix = nil
+		if v == "" {
+			t.ThoroughfareNumber = nil
+			return
+		}
+		t.ThoroughfareNumber = []*ThoroughfareNumber{{Text: v}}
+		return
+	}
+	if v == "" {
+		if p := s.a.premise(); p != nil {
+			p.PremiseNumber, p.PremiseNumberSuffix = nil, nil
+		}
+		return
+	}
+	p := s.premise()
+	p.PremiseNumber, p.PremiseNumberSuffix = []*PremiseNumber{{Text: v}}, nil
+}
+
+// SetCountryCode sets the country code. Setting a code on an address without a country
+// moves its top level administrative area or locality under a new Country.
+func (s Setter) SetCountryCode(v string) {
+	if v == "" {
+		if s.a.Country != nil {
+			s.a.Country.CountryNameCode = nil
+		}
+		return
+	}
+	c := s.country()
+	if len(c.CountryNameCode) == 0 {
+		c.CountryNameCode = []*CountryNameCode{{}}
+	}
+	c.CountryNameCode[0].Text = v
+}
+
+// SetAdminArea sets the first name of the administrative area. Creating an administrative area
+// moves the locality that was stored in its place under it.
+func (s Setter) SetAdminArea(v string) {
+	aa := s.a.administrativeArea()
+	if v == "" {
+		if aa != nil && len(aa.AdministrativeAreaName) > 0 {
+			aa.AdministrativeAreaName = aa.AdministrativeAreaName[1:]
+		}
+		return
+	}
+	if aa == nil {
+		aa = s.administrativeArea()
+	}
+	if len(aa.AdministrativeAreaName) == 0 {
+		aa.AdministrativeAreaName = []*AdministrativeAreaName{{}}
+	}
+	aa.AdministrativeAreaName[0].Text = v
+}
+
+// country returns the country of the address, creating it if needed.
+func (s Setter) country() *Country {
+	a := s.a
+	if a.Country == nil {
+		a.Country = &Country{}
+		switch {
+		case a.AdministrativeArea != nil:
+			a.Country.AdministrativeArea, a.AdministrativeArea = a.AdministrativeArea, nil
+		case a.Locality != nil:
+			a.Country.Locality, a.Locality = a.Locality, nil
+		}
+	}
+	return a.Country
+}
+
+// administrativeArea returns the administrative area of the address, creating it if needed.
+func (s Setter) administrativeArea() *AdministrativeArea {
+	if aa := s.a.administrativeArea(); aa != nil {
+		return aa
+	}
+	aa := &AdministrativeArea{}
+	if s.a.Country != nil || s.branch == BranchCountry {
+		c := s.country()
+		aa.Locality, c.Locality = c.Locality, nil
+		c.AdministrativeArea = aa
+		return aa
+	}
+	aa.Locality, s.a.Locality = s.a.Locality, nil
+	s.a.AdministrativeArea = aa
+	return aa
+}
+
+// district adds an administrative area name of type District, eg. a county.
+func (s Setter) district(v string) {
+	aa := s.administrativeArea()
+	aa.AdministrativeAreaName = append(aa.AdministrativeAreaName, &AdministrativeAreaName{AttrType: "District", Text: v})
+}
+
+// locality returns the locality of the address, creating it if needed.
+func (s Setter) locality() *Locality {
+	if l := s.a.locality(); l != nil {
+		return l
+	}
+	l := &Locality{}
+	switch {
+	case s.a.AdministrativeArea != nil || (s.a.Country != nil && s.a.Country.AdministrativeArea != nil):
+		s.a.administrativeArea().Locality = l
+	case s.a.Country != nil || s.branch == BranchCountry:
+		s.country().Locality = l
+	case s.branch == BranchAdministrativeArea:
+		s.administrativeArea().Locality = l
+	default:
+		s.a.Locality = l
+	}
+	return l
+}
+
+// thoroughfare returns the thoroughfare of the address, creating it on the locality if needed.
+func (s Setter) thoroughfare() *Thoroughfare {
+	if t := s.a.thoroughfare(); t != nil {
+		return t
+	}
+	l := s.locality()
+	l.Thoroughfare = &Thoroughfare{}
+	return l.Thoroughfare
+}
+
+// premise returns the premise of the address, creating it on the thoroughfare if needed.
+func (s Setter) premise() *Premise {
+	if p := s.a.premise(); p != nil {
+		return p
+	}
+	t := s.thoroughfare()
+	t.Premise = &Premise{}
+	return t.Premise
+}
+
+// dependentLocality returns the dependent locality of the address, creating it on the locality if needed.
+func (s Setter) dependentLocality() *DependentLocality {
+	if dl := s.a.dependentLocality(); dl != nil {
+		return dl
+	}
+	l := s.locality()
+	l.DependentLocality = &DependentLocality{}
+	return l.DependentLocality
+}
+
+// subPremise returns the first sub-premise of the address, creating it on the premise if needed.
+func (s Setter) subPremise() *SubPremise {
+	if sp := s.a.subPremise(); sp != nil {
+		return sp
+	}
+	p := s.premise()
+	p.SubPremise = append(p.SubPremise, &SubPremise{})
+	return p.SubPremise[0]
+}
+
+// postalCode returns the postal code of the address, creating it on the locality if needed.
+func (s Setter) postalCode() *PostalCode {
+	if pc := s.a.postalCode(); pc != nil {
+		return pc
+	}
+	l := s.locality()
+	l.PostalCode = &PostalCode{}
+	return l.PostalCode
+}
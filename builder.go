@@ -0,0 +1,170 @@
+package xal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// AddressBuilder - Builds an AddressDetails one component at a time, placing each component
+// where the spec expects it:
+//
+//	a, err := xal.NewAddress().
+//		Country("US").AdminArea("CA").Locality("San Francisco").
+//		Street("Market", "St").Number("1").Unit("Apt", "4").
+//		PostalCode("94105").
+//		Build()
+//
+// Components can be given in any order. Errors are collected and returned by Build.
+type AddressBuilder struct {
+	a    *AddressDetails
+	set  Setter
+	errs ValidationErrors
+}
+
+// NewAddress returns a builder creating its nodes in BranchCountry.
+func NewAddress() *AddressBuilder {
+	return NewAddressIn(BranchCountry)
+}
+
+// NewAddressIn returns a builder creating its nodes in branch b.
+func NewAddressIn(b Branch) *AddressBuilder {
+	a := &AddressDetails{}
+	return &AddressBuilder{a: a, set: a.In(b)}
+}
+
+// Country sets the ISO 3166 country code, eg. US or USA.
+func (b *AddressBuilder) Country(code string) *AddressBuilder {
+	code = strings.TrimSpace(code)
+	if (len(code) != 2 && len(code) != 3) || strings.IndexFunc(code, func(r rune) bool { return !unicode.IsLetter(r) }) >= 0 {
+		return b.fail("country", "%q is not an ISO 3166 country code", code)
+	}
+	b.set.SetCountryCode(strings.ToUpper(code))
+	b.a.Country.CountryNameCode[0].AttrScheme = fmt.Sprintf("iso.3166-%d", len(code))
+	return b
+}
+
+// CountryName sets the name of the country.
+func (b *AddressBuilder) CountryName(name string) *AddressBuilder {
+	if name == "" {
+		return b.fail("country_name", "country name is empty")
+	}
+	b.set.country().CountryName = []*CountryName{{Text: name}}
+	return b
+}
+
+// AdminArea sets the name of the administrative area, eg. a state or a province.
+func (b *AddressBuilder) AdminArea(name string) *AddressBuilder {
+	if name == "" {
+		return b.fail("administrative_area", "administrative area name is empty")
+	}
+	b.set.SetAdminArea(name)
+	return b
+}
+
+// Locality sets the name of the locality, eg. a city.
+func (b *AddressBuilder) Locality(name string) *AddressBuilder {
+	if name == "" {
+		return b.fail("locality", "locality name is empty")
+	}
+	b.set.SetLocalityName(name)
+	return b
+}
+
+// DependentLocality sets the name of the dependent locality, eg. a district or a suburb.
+func (b *AddressBuilder) DependentLocality(name string) *AddressBuilder {
+	if name == "" {
+		return b.fail("dependent_locality", "dependent locality name is empty")
+	}
+	l := b.set.locality()
+	if l.DependentLocality == nil {
+		l.DependentLocality = &DependentLocality{}
+	}
+	l.DependentLocality.DependentLocalityName = []*DependentLocalityName{{Text: name}}
+	return b
+}
+
+// Street sets the name of the thoroughfare and its trailing type, eg. Street("Market", "St").
+// The type may be empty.
+func (b *AddressBuilder) Street(name, typ string) *AddressBuilder {
+	if name == "" {
+		return b.fail("thoroughfare", "street name is empty")
+	}
+	b.set.SetStreetName(name)
+	t := b.a.thoroughfare()
+	t.ThoroughfareTrailingType = nil
+	if typ != "" {
+		t.ThoroughfareTrailingType = &ThoroughfareTrailingType{Text: typ}
+	}
+	return b
+}
+
+// Number sets the house number, eg. 12 or 12A.
+func (b *AddressBuilder) Number(n string) *AddressBuilder {
+	if n == "" {
+		return b.fail("premise", "house number is empty")
+	}
+	b.set.SetHouseNumber(n)
+	return b
+}
+
+// Unit adds a sub-premise to the premise, eg. Unit("Apt", "4"). The type may be empty.
+func (b *AddressBuilder) Unit(typ, number string) *AddressBuilder {
+	if number == "" {
+		return b.fail("sub_premise", "unit number is empty")
+	}
+	p := b.set.premise()
+	p.SubPremise = append(p.SubPremise, &SubPremise{
+		AttrType:         typ,
+		SubPremiseNumber: []*SubPremiseNumber{{Text: number}},
+	})
+	return b
+}
+
+// PostBox sets the post box number on the locality.
+func (b *AddressBuilder) PostBox(number string) *AddressBuilder {
+	if number == "" {
+		return b.fail("post_box", "post box number is empty")
+	}
+	l := b.set.locality()
+	if l.PostBox == nil {
+		l.PostBox = &PostBox{}
+	}
+	l.PostBox.PostBoxNumber = &PostBoxNumber{Text: number}
+	return b
+}
+
+// PostalCode sets the postal code.
+func (b *AddressBuilder) PostalCode(code string) *AddressBuilder {
+	if code == "" {
+		return b.fail("postal_code", "postal code is empty")
+	}
+	b.set.SetPostalCode(code)
+	return b
+}
+
+// Usage sets the usage of the address, eg. Home or Work. AttrUsage is at most 6 characters long.
+func (b *AddressBuilder) Usage(usage string) *AddressBuilder {
+	b.a.AttrUsage = usage
+	return b
+}
+
+// Build returns the address, or the errors met while building it and validating it against the spec.
+func (b *AddressBuilder) Build() (*AddressDetails, error) {
+	errs := b.errs
+	if b.a.Country == nil && b.a.AdministrativeArea == nil && b.a.Locality == nil {
+		errs = append(errs, &ValidationError{Reason: "address is empty"})
+	}
+	if err := b.a.Validate(); err != nil {
+		errs = append(errs, err.(ValidationErrors)...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return b.a, nil
+}
+
+func (b *AddressBuilder) fail(field, format string, args ...any) *AddressBuilder {
+	b.errs = append(b.errs, &ValidationError{Field: field, Reason: fmt.Sprintf(format, args...)})
+	return b
+}
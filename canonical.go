@@ -0,0 +1,105 @@
+package xal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// FingerprintVersion is the version of the canonical form hashed by Fingerprint.
+// It is bumped whenever a change to the canonical form changes existing fingerprints.
+const FingerprintVersion = 3
+
+// Canonical returns a copy of the address reduced to the components that identify a delivery point,
+// normalised and placed in a single branch of the tree:
+//
+//	Country/AdministrativeArea/Locality/DependentLocality
+//	Country/AdministrativeArea/Locality/PostalCode
+//	Country/AdministrativeArea/Locality/PostBox
+//	Country/AdministrativeArea/Locality/Thoroughfare/Premise/SubPremise
+//
+// Names are lower-cased with abbreviations expanded, identifiers such as postal codes and numbers are
+// upper-cased with separators removed, and empty nodes are omitted. Addresses that only differ in casing,
+// whitespace, abbreviations or in the branch used to store their components share the same canonical form.
+func (a *AddressDetails) Canonical() *AddressDetails {
+	c := &Country{}
+	if a != nil && a.Country != nil {
+		if code := a.Country.code(); code != "" {
+			c.CountryNameCode = []*CountryNameCode{{Text: normalizeCode(code)}}
+		} else if name := a.Country.name(); name != "" {
+			c.CountryName = []*CountryName{{Text: normalize(name)}}
+		}
+	}
+
+	l := &Locality{}
+	if name := normalize(a.locality().name()); name != "" {
+		l.LocalityName = []*LocalityName{{Text: name}}
+	}
+	if name := normalize(a.dependentLocality().name()); name != "" {
+		l.DependentLocality = &DependentLocality{DependentLocalityName: []*DependentLocalityName{{Text: name}}}
+	}
+	if code := normalizeCode(a.postalCode().number()); code != "" {
+		l.PostalCode = &PostalCode{PostalCodeNumber: []*PostalCodeNumber{{Text: code}}}
+	}
+	if pb := a.postBox(); pb != nil && pb.PostBoxNumber != nil && pb.PostBoxNumber.Text != "" {
+		l.PostBox = &PostBox{PostBoxNumber: &PostBoxNumber{Text: normalizeCode(pb.PostBoxNumber.Text)}}
+	}
+
+	p := &Premise{}
+	if n := normalizeCode(a.component(ComponentHouseNumber)); n != "" {
+		p.PremiseNumber = []*PremiseNumber{{Text: n}}
+	}
+	if u := normalizeCode(a.component(ComponentUnit)); u != "" {
+		p.SubPremise = []*SubPremise{{SubPremiseNumber: []*SubPremiseNumber{{Text: u}}}}
+	}
+	t := &Thoroughfare{}
+	if name := normalize(a.thoroughfare().street()); name != "" {
+		t.ThoroughfareName = []*ThoroughfareName{{Text: name}}
+	}
+	if p.PremiseNumber != nil || p.SubPremise != nil {
+		t.Premise = p
+	}
+	if t.ThoroughfareName != nil || t.Premise != nil {
+		l.Thoroughfare = t
+	}
+
+	aa := &AdministrativeArea{}
+	if name := normalize(a.administrativeArea().name()); name != "" {
+		aa.AdministrativeAreaName = []*AdministrativeAreaName{{Text: name}}
+	}
+	if l.LocalityName != nil || l.DependentLocality != nil || l.PostalCode != nil || l.PostBox != nil || l.Thoroughfare != nil {
+		aa.Locality = l
+	}
+	if aa.AdministrativeAreaName != nil || aa.Locality != nil {
+		c.AdministrativeArea = aa
+	}
+
+	out := &AddressDetails{}
+	if c.CountryNameCode != nil || c.CountryName != nil || c.AdministrativeArea != nil {
+		out.Country = c
+	}
+	return out
+}
+
+// Fingerprint returns a stable digest of the canonical form of the address,
+// suitable as a cache or deduplication key.
+//
+// The digest is the SHA-256 of the components of the flattened canonical form, sorted by name,
+// a line per component written as name="value" with the value quoted as by strconv.Quote.
+// It does not depend on the JSON encoding of the types. It is prefixed with FingerprintVersion,
+// eg. "v3:3a7bd3e2...", so that fingerprints computed by different versions of the canonical form never collide.
+func (a *AddressDetails) Fingerprint() string {
+	flat, _ := a.Canonical().Flatten()
+	names := make([]string, 0, len(flat))
+	for c := range flat {
+		names = append(names, string(c))
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, strconv.Quote(flat[Component(name)]))
+	}
+	return fmt.Sprintf("v%d:%s", FingerprintVersion, hex.EncodeToString(h.Sum(nil)))
+}
@@ -0,0 +1,103 @@
+package xal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	base := &AddressDetails{Country: &Country{
+		CountryNameCode: []*CountryNameCode{{Text: "US"}},
+		AdministrativeArea: &AdministrativeArea{
+			AdministrativeAreaName: []*AdministrativeAreaName{{Text: "CA"}},
+			Locality: &Locality{
+				LocalityName: []*LocalityName{{Text: "San Francisco"}},
+				Thoroughfare: &Thoroughfare{
+					ThoroughfareName:         []*ThoroughfareName{{Text: "Market"}},
+					ThoroughfareTrailingType: &ThoroughfareTrailingType{Text: "St"},
+					Premise:                  &Premise{PremiseNumber: []*PremiseNumber{{Text: "1"}}},
+				},
+				PostalCode: &PostalCode{PostalCodeNumber: []*PostalCodeNumber{{Text: "94105"}}},
+			},
+		},
+	}}
+	// SHA-256 of the lines admin_area="ca", country_code="US", house_number="1", locality="san francisco",
+	// postal_code="94105" and street_name="market street". It only changes with FingerprintVersion.
+	const golden = "v3:fd2945563d58036a0978845f572f8042291b4c0d95b15cd0d2379244b5b3d4d4"
+
+	tests := []struct {
+		name string
+		a    *AddressDetails
+		same bool // whether the fingerprint is that of base
+	}{
+		{"base", base, true},
+		{"casing and spacing", &AddressDetails{Country: &Country{
+			CountryNameCode: []*CountryNameCode{{Text: " us "}},
+			AdministrativeArea: &AdministrativeArea{
+				AdministrativeAreaName: []*AdministrativeAreaName{{Text: "ca"}},
+				Locality: &Locality{
+					LocalityName: []*LocalityName{{Text: "SAN  FRANCISCO"}},
+					Thoroughfare: &Thoroughfare{
+						ThoroughfareName:         []*ThoroughfareName{{Text: "MARKET"}},
+						ThoroughfareTrailingType: &ThoroughfareTrailingType{Text: "st"},
+						Premise:                  &Premise{PremiseNumber: []*PremiseNumber{{Text: "1"}}},
+					},
+					PostalCode: &PostalCode{PostalCodeNumber: []*PostalCodeNumber{{Text: "94105"}}},
+				},
+			},
+		}}, true},
+		{"postal code on the thoroughfare", &AddressDetails{Country: &Country{
+			CountryNameCode: []*CountryNameCode{{Text: "US"}},
+			AdministrativeArea: &AdministrativeArea{
+				AdministrativeAreaName: []*AdministrativeAreaName{{Text: "CA"}},
+				Locality: &Locality{
+					LocalityName: []*LocalityName{{Text: "San Francisco"}},
+					Thoroughfare: &Thoroughfare{
+						ThoroughfareName:         []*ThoroughfareName{{Text: "Market"}},
+						ThoroughfareTrailingType: &ThoroughfareTrailingType{Text: "St"},
+						Premise:                  &Premise{PremiseNumber: []*PremiseNumber{{Text: "1"}}},
+						PostalCode:               &PostalCode{PostalCodeNumber: []*PostalCodeNumber{{Text: "94105"}}},
+					},
+				},
+			},
+		}}, true},
+		{"values of no component", &AddressDetails{AttrUsage: "Business", Country: base.Country}, true},
+		{"other number", &AddressDetails{Country: &Country{
+			CountryNameCode: []*CountryNameCode{{Text: "US"}},
+			AdministrativeArea: &AdministrativeArea{
+				AdministrativeAreaName: []*AdministrativeAreaName{{Text: "CA"}},
+				Locality: &Locality{
+					LocalityName: []*LocalityName{{Text: "San Francisco"}},
+					Thoroughfare: &Thoroughfare{
+						ThoroughfareName:         []*ThoroughfareName{{Text: "Market"}},
+						ThoroughfareTrailingType: &ThoroughfareTrailingType{Text: "St"},
+						Premise:                  &Premise{PremiseNumber: []*PremiseNumber{{Text: "11"}}},
+					},
+					PostalCode: &PostalCode{PostalCodeNumber: []*PostalCodeNumber{{Text: "94105"}}},
+				},
+			},
+		}}, false},
+		{"empty", &AddressDetails{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Fingerprint()
+			if !strings.HasPrefix(got, "v3:") || len(got) != len(golden) {
+				t.Fatalf("Fingerprint() = %q, want v3: and a SHA-256 digest", got)
+			}
+			if same := got == golden; same != tt.same {
+				t.Errorf("Fingerprint() = %q, golden %q, want same = %v", got, golden, tt.same)
+			}
+		})
+	}
+}
+
+func TestFingerprintStable(t *testing.T) {
+	a := &AddressDetails{Country: &Country{CountryNameCode: []*CountryNameCode{{Text: "FR"}}}}
+	want := a.Fingerprint()
+	for i := 0; i < 20; i++ {
+		if got := a.Fingerprint(); got != want {
+			t.Fatalf("Fingerprint() = %q, then %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// docWidth is the width at which doc comments are wrapped.
+const docWidth = 110
+
+// generate returns the source of the types and constants of the elements declared in the schema.
+// The type of the root element comes first, eg. XAL, then the types in alphabetical order.
+func (m *model) generate(s *schema, pkg string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by xalgen from %s; DO NOT EDIT.\n\npackage %s\n\n", filepath.Base(s.file), pkg)
+
+	var types []*goType
+	for _, t := range m.ordered {
+		if t.schema == s {
+			types = append(types, t)
+		}
+	}
+	if len(types) > 0 {
+		root := m.roots[s]
+		sort.Slice(types, func(i, j int) bool {
+			if types[i].name == root || types[j].name == root {
+				return types[i].name == root
+			}
+			return types[i].name < types[j].name
+		})
+		b.WriteString("type (\n")
+		for i, t := range types {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			m.writeType(&b, t)
+		}
+		b.WriteString(")\n")
+	}
+
+	var enums []*enum
+	for _, e := range m.enums {
+		if e.schema == s {
+			enums = append(enums, e)
+		}
+	}
+	sort.Slice(enums, func(i, j int) bool { return enums[i].name < enums[j].name })
+	for _, e := range enums {
+		kind := "elements"
+		if e.attr {
+			kind = "attributes"
+		}
+		fmt.Fprintf(&b, "\n// Values of the %s %s.\nconst (\n", e.name, kind)
+		for _, v := range e.values {
+			if exported(v) == "" {
+				continue // eg. -, which has no Go name
+			}
+			name := e.name + exported(v)
+			if m.types[name] != nil {
+				name += "Value"
+			}
+			fmt.Fprintf(&b, "%s = %q\n", name, v)
+		}
+		b.WriteString(")\n")
+	}
+	return b.Bytes()
+}
+
+// writeType writes the declaration of t, a struct or a slice type, with its doc comment.
+func (m *model) writeType(b *bytes.Buffer, t *goType) {
+	doc := t.doc
+	if doc == "" {
+		doc = "The " + t.name + " element."
+	}
+	writeDoc(b, t.name+" - "+doc)
+	if t.elem != "" {
+		fmt.Fprintf(b, "%s []*%s\n", t.name, t.elem)
+		return
+	}
+	fmt.Fprintf(b, "%s struct {\n", t.name)
+	// The attributes come first, then the child elements in schema order, then the text.
+	fields := slices.Clone(t.fields)
+	slices.SortStableFunc(fields, func(f, g *goField) int {
+		switch {
+		case f.attr == g.attr:
+			return 0
+		case f.attr:
+			return -1
+		}
+		return 1
+	})
+	if t.text != nil {
+		fields = append(fields[:len(fields):len(fields)], t.text)
+	}
+	for _, f := range fields {
+		typ, xmlTag := "string", f.xml+",omitempty"
+		switch {
+		case f == t.text:
+			xmlTag = ",chardata"
+		case f.attr:
+			xmlTag = f.xml + ",attr,omitempty"
+		case f.typ == "" && f.slice:
+			typ = "[]string"
+		case f.typ == "":
+		case f.slice:
+			typ = "[]*" + f.typ
+		default:
+			typ = "*" + f.typ
+			if c := m.types[f.typ]; c.elem != "" {
+				xmlTag = f.xml + ">" + c.elemXML + ",omitempty"
+			}
+		}
+		json := snake(f.name)
+		if f.attr {
+			json = "attr_" + snake(strings.TrimPrefix(f.name, "Attr"))
+		}
+		fmt.Fprintf(b, "%s %s `json:\"%s,omitempty\" xml:\"%s\"`", f.name, typ, json, xmlTag)
+		if c := f.comment(); c != "" {
+			b.WriteString(" // " + c)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n")
+}
+
+// comment returns the trailing comment of the field: its constraints, read by gen_model.go, and its documentation.
+func (f *goField) comment() string {
+	var parts []string
+	if f.maxLength > 0 {
+		parts = append(parts, fmt.Sprintf("maxLength=%d", f.maxLength))
+	}
+	if len(f.enum) > 0 {
+		parts = append(parts, "enum="+strings.Join(f.enum, "|"))
+	}
+	if f.choice {
+		parts = append(parts, "choice")
+	}
+	if f.doc != "" {
+		parts = append(parts, f.doc)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// writeDoc writes the text as a doc comment wrapped at docWidth.
+func writeDoc(b *bytes.Buffer, text string) {
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > docWidth && line != "//" {
+			b.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	b.WriteString(line + "\n")
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// applyLimits sets the maxLength facet of the fields listed in file, a line per field, eg.
+// "PostBox.AttrType 5", with blank lines and lines starting with # ignored.
+// A field the model does not have is an error, so that the limits follow the schemas.
+func (m *model) applyLimits(file string) error {
+	fh, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+	sc := bufio.NewScanner(fh)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return fmt.Errorf("%s:%d: want Type.Field maxLength, got %q", file, line, text)
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil || n <= 0 {
+			return fmt.Errorf("%s:%d: maxLength %q is not a positive integer", file, line, fields[1])
+		}
+		f := m.field(fields[0])
+		if f == nil {
+			return fmt.Errorf("%s:%d: %s is not a field of the model", file, line, fields[0])
+		}
+		f.maxLength = n
+	}
+	return sc.Err()
+}
+
+// field returns the field named Type.Field, eg. PostBox.AttrType or PostBoxNumber.Text, or nil.
+func (m *model) field(name string) *goField {
+	typ, field, ok := strings.Cut(name, ".")
+	t := m.types[typ]
+	if !ok || t == nil {
+		return nil
+	}
+	if field == "Text" {
+		return t.text
+	}
+	for _, f := range t.fields {
+		if f.name == field && f.typ == "" {
+			return f
+		}
+	}
+	return nil
+}
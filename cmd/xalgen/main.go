@@ -0,0 +1,86 @@
+// Command xalgen generates the Go types of the xal package from the XML schemas of the OASIS CIQ
+// xAL and xNL 2.0 specifications, xAL.xsd and xNL.xsd, which can be downloaded with the spec,
+// see https://www.oasis-open.org/committees/ciq/download.html.
+//
+// Usage:
+//
+//	xalgen [-pkg xal] [-d dir] [-limits file] xAL.xsd [xNL.xsd ...]
+//
+// The schemas are expected in the schema directory of package xal, see its go:generate directive.
+//
+// Each schema gives a file of dir named after it, eg. xal.go for xAL.xsd, holding a type per element:
+//
+//   - an attribute is a string field prefixed with Attr, eg. AttrType, tagged json:"attr_type" and xml:"Type,attr"
+//   - the text of an element of simple or mixed content is its Text field
+//   - the attributes come first, then the child elements in schema order
+//   - a child element is a pointer field, or a slice field when it can repeat
+//   - an element holding nothing but a repeated element, eg. AddressLines, is a slice type,
+//     whose fields are tagged with the path to the repeated element, eg. xml:"AddressLines>AddressLine"
+//
+// The documentation of the schema becomes the doc comments, and the facets of the values become the trailing
+// comments read by gen_model.go, see the documentation of package xal: maxLength=N, enum=A|B and choice for the
+// elements of a choice. The enumerations also give constants, eg. NumberTypeSingle.
+//
+// The schemas are used as published. The length limits the package adds on top of them are kept in the file
+// of -limits, schema/limits.txt, a line per field, eg. "PostBox.AttrType 5", naming the generated type and field.
+//
+// The wildcards xs:any and xs:anyAttribute, and the attributes of the xml namespace, eg. xml:lang, are left out.
+// References between the schemas are resolved by name, eg. xNL elements referenced from xAL, so that every
+// schema referenced has to be passed.
+// Run go generate in the package rather than xalgen alone, to update model_gen.go and xal.schema.json too.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("xalgen: ")
+	pkg := flag.String("pkg", "xal", "package of the generated files")
+	dir := flag.String("d", ".", "directory of the generated files")
+	limits := flag.String("limits", "", "file of the length limits of the fields")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: xalgen [-pkg xal] [-d dir] [-limits file] xAL.xsd [xNL.xsd ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var schemas []*schema
+	for _, file := range flag.Args() {
+		s, err := loadSchema(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		schemas = append(schemas, s)
+	}
+	m, err := buildModel(schemas)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *limits != "" {
+		if err := m.applyLimits(*limits); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, s := range schemas {
+		src, err := format.Source(m.generate(s, *pkg))
+		if err != nil {
+			log.Fatalf("%s: %v", s.file, err)
+		}
+		name := strings.ToLower(strings.TrimSuffix(filepath.Base(s.file), filepath.Ext(s.file))) + ".go"
+		if err := os.WriteFile(filepath.Join(*dir, name), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
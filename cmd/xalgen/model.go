@@ -0,0 +1,437 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// model - The Go types of the elements of the schemas.
+type model struct {
+	defs    map[string]map[string]*node // Global definitions by kind, eg. complexType, and name
+	owner   map[*node]*schema           // Schema of the global definitions
+	types   map[string]*goType          // Types by Go name
+	keys    map[string]*goType          // Types by definition, see elementType
+	enums   map[string]*enum            // Enumerations by Go name of the attribute or element
+	roots   map[*schema]string          // Type of the first global element of each schema, eg. XAL
+	ordered []*goType                   // Types in the order they were found
+}
+
+// goType - The Go type of an element.
+type goType struct {
+	name    string
+	schema  *schema
+	doc     string
+	fields  []*goField
+	text    *goField // Text of an element of simple or mixed content
+	elem    string   // Element type of a slice type, eg. AddressLine of AddressLines
+	elemXML string   // XML name of the element of a slice type
+}
+
+// goField - A field of a goType: an attribute, a child element or the text of the element.
+type goField struct {
+	name   string // eg. AttrType, AddressLine or Text
+	xml    string // XML name of the attribute or child element
+	attr   bool
+	typ    string // Go type of a child element, "" for a string
+	slice  bool
+	choice bool
+	facets
+	doc string
+}
+
+// facets - The constraints on a string value.
+type facets struct {
+	maxLength int
+	enum      []string
+}
+
+// enum - The values of the enumerated attributes or elements of a name.
+type enum struct {
+	name   string
+	attr   bool
+	schema *schema
+	values []string
+}
+
+// buildModel returns the types of the global elements of the schemas, and of the elements they hold.
+func buildModel(schemas []*schema) (*model, error) {
+	m := &model{
+		defs:  map[string]map[string]*node{},
+		owner: map[*node]*schema{},
+		types: map[string]*goType{},
+		keys:  map[string]*goType{},
+		enums: map[string]*enum{},
+		roots: map[*schema]string{},
+	}
+	for _, s := range schemas {
+		for _, n := range s.root.children("element", "complexType", "simpleType", "attributeGroup", "group", "attribute") {
+			kind := n.XMLName.Local
+			if m.defs[kind] == nil {
+				m.defs[kind] = map[string]*node{}
+			}
+			if _, ok := m.defs[kind][n.attr("name")]; !ok {
+				m.defs[kind][n.attr("name")] = n
+				m.owner[n] = s
+			}
+		}
+	}
+	for _, s := range schemas {
+		for i, el := range s.root.children("element") {
+			typ, _, err := m.elementType(el, "", s)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", s.file, err)
+			}
+			if i == 0 {
+				m.roots[s] = typ
+			}
+		}
+	}
+	// Elements holding nothing but a repeated element are slices of that element, but for the roots.
+	for _, t := range m.ordered {
+		if t.text == nil && len(t.fields) == 1 && m.roots[t.schema] != t.name {
+			if f := t.fields[0]; !f.attr && f.slice && f.typ != "" {
+				t.elem, t.elemXML = f.typ, f.xml
+			}
+		}
+	}
+	return m, nil
+}
+
+// global returns the global definition of the kind referenced by qname.
+func (m *model) global(kind, qname string) (*node, error) {
+	if n := m.defs[kind][local(qname)]; n != nil {
+		return n, nil
+	}
+	return nil, fmt.Errorf("%s %s is not declared, pass the schema declaring it", kind, qname)
+}
+
+// elementType returns the Go type of the element, registering it on first use: the name of a goType
+// for an element of complex type, or "" for a string with its facets.
+//
+// An element of a named complex type shares its goType with the elements of the same name and type,
+// eg. the AddressLine elements of AddressLineType, and an element of an anonymous type has its own.
+// The goType is named after the element, prefixed with the parent element if the name is taken, eg. LocalityName.
+func (m *model) elementType(el *node, parent string, s *schema) (string, facets, error) {
+	if ref := el.attr("ref"); ref != "" {
+		g, err := m.global("element", ref)
+		if err != nil {
+			return "", facets{}, err
+		}
+		return m.elementType(g, "", m.owner[g])
+	}
+	name := el.attr("name")
+	ct, st := el.child("complexType"), el.child("simpleType")
+	key := fmt.Sprintf("%p", el)
+	if typ := el.attr("type"); ct == nil && st == nil && typ != "" {
+		if n := m.defs["complexType"][local(typ)]; n != nil {
+			ct, key = n, name+" "+local(typ)
+		} else if n := m.defs["simpleType"][local(typ)]; n != nil {
+			st = n
+		}
+	}
+	if ct == nil {
+		f, err := m.simpleFacets(st)
+		if err == nil && len(f.enum) > 0 {
+			m.addEnum(exported(name), false, s, f.enum)
+		}
+		return "", f, err
+	}
+	if t := m.keys[key]; t != nil {
+		return t.name, facets{}, nil
+	}
+	goName := exported(name)
+	if m.types[goName] != nil {
+		goName = exported(parent) + goName
+	}
+	for i := 2; m.types[goName] != nil; i++ {
+		goName = exported(name) + strconv.Itoa(i)
+	}
+	doc := el.documentation()
+	if doc == "" {
+		doc = ct.documentation()
+	}
+	t := &goType{name: goName, schema: s, doc: doc}
+	m.types[goName], m.keys[key] = t, t
+	m.ordered = append(m.ordered, t)
+	if err := m.complexType(t, ct, s); err != nil {
+		return "", facets{}, fmt.Errorf("%s: %v", name, err)
+	}
+	return goName, facets{}, nil
+}
+
+// complexType adds the attributes, child elements and text of the complex type to t.
+func (m *model) complexType(t *goType, ct *node, s *schema) error {
+	if ct.attr("mixed") == "true" {
+		m.setText(t, facets{})
+	}
+	content := ct
+	if c := ct.child("simpleContent"); c != nil {
+		d := derivation(c)
+		if d == nil {
+			return nil
+		}
+		if err := m.derive(t, d, s); err != nil {
+			return err
+		}
+		f, err := m.simpleFacets(d)
+		if err != nil {
+			return err
+		}
+		m.setText(t, f)
+		return m.attributes(t, d, s)
+	}
+	if c := ct.child("complexContent"); c != nil {
+		if c.attr("mixed") == "true" {
+			m.setText(t, facets{})
+		}
+		d := derivation(c)
+		if d == nil {
+			return nil
+		}
+		// A restriction restates the content of its base, an extension adds to it.
+		if d.XMLName.Local == "extension" {
+			if err := m.derive(t, d, s); err != nil {
+				return err
+			}
+		}
+		content = d
+	}
+	if err := m.particles(t, content, s, false, false); err != nil {
+		return err
+	}
+	return m.attributes(t, content, s)
+}
+
+// derivation returns the extension or the restriction of a simple or complex content, or nil.
+func derivation(content *node) *node {
+	if d := content.children("extension", "restriction"); len(d) > 0 {
+		return d[0]
+	}
+	return nil
+}
+
+// derive adds the content of the base type of an extension or restriction to t.
+func (m *model) derive(t *goType, d *node, s *schema) error {
+	base := d.attr("base")
+	if n := m.defs["complexType"][local(base)]; n != nil {
+		return m.complexType(t, n, m.owner[n])
+	}
+	if n := m.defs["simpleType"][local(base)]; n != nil {
+		f, err := m.simpleFacets(n)
+		if err != nil {
+			return err
+		}
+		m.setText(t, f)
+		return nil
+	}
+	// A built-in type, eg. xs:string
+	if local(base) != "anyType" {
+		m.setText(t, facets{})
+	}
+	return nil
+}
+
+// setText gives t a Text field with the facets, merged with those of the base types.
+func (m *model) setText(t *goType, f facets) {
+	if t.text == nil {
+		t.text = &goField{name: "Text"}
+	}
+	if f.maxLength > 0 {
+		t.text.maxLength = f.maxLength
+	}
+	if len(f.enum) > 0 {
+		t.text.enum = f.enum
+		m.addEnum(t.name, false, t.schema, f.enum)
+	}
+}
+
+// particles adds the child elements of the sequences, choices and groups of n to t. The elements of a choice
+// of more than one particle are marked choice, unless the choice repeats; repeated elements are slices.
+func (m *model) particles(t *goType, n *node, s *schema, choice, repeat bool) error {
+	for _, c := range n.children("element", "sequence", "choice", "all", "group") {
+		switch c.XMLName.Local {
+		case "element":
+			typ, f, err := m.elementType(c, t.name, s)
+			if err != nil {
+				return err
+			}
+			name := local(c.attr("name") + c.attr("ref"))
+			m.addField(t, &goField{
+				name:   exported(name),
+				xml:    name,
+				typ:    typ,
+				slice:  repeat || c.repeats(),
+				choice: choice,
+				facets: f,
+			})
+		case "sequence", "all":
+			if err := m.particles(t, c, s, false, repeat || c.repeats()); err != nil {
+				return err
+			}
+		case "choice":
+			rep := repeat || c.repeats()
+			alternatives := len(c.children("element", "sequence", "choice", "all", "group"))
+			if err := m.particles(t, c, s, alternatives > 1 && !rep, rep); err != nil {
+				return err
+			}
+		case "group":
+			g, err := m.global("group", c.attr("ref"))
+			if err != nil {
+				return err
+			}
+			if err := m.particles(t, g, m.owner[g], choice, repeat || c.repeats()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// attributes adds the attributes and attribute groups of n to t. Attributes of the xml namespace, eg. xml:lang,
+// are left out, as are the prohibited ones.
+func (m *model) attributes(t *goType, n *node, s *schema) error {
+	for _, c := range n.children("attribute", "attributeGroup") {
+		if c.XMLName.Local == "attributeGroup" {
+			g, err := m.global("attributeGroup", c.attr("ref"))
+			if err != nil {
+				return err
+			}
+			if err := m.attributes(t, g, m.owner[g]); err != nil {
+				return err
+			}
+			continue
+		}
+		a := c
+		if ref := c.attr("ref"); ref != "" {
+			if strings.HasPrefix(ref, "xml:") {
+				continue
+			}
+			g, err := m.global("attribute", ref)
+			if err != nil {
+				return err
+			}
+			a = g
+		}
+		if c.attr("use") == "prohibited" {
+			continue
+		}
+		st := a.child("simpleType")
+		if typ := a.attr("type"); st == nil && typ != "" {
+			st = m.defs["simpleType"][local(typ)]
+		}
+		f, err := m.simpleFacets(st)
+		if err != nil {
+			return err
+		}
+		name := a.attr("name")
+		if len(f.enum) > 0 {
+			m.addEnum(exported(name), true, t.schema, f.enum)
+		}
+		doc := c.documentation()
+		if doc == "" {
+			doc = a.documentation()
+		}
+		m.addField(t, &goField{name: "Attr" + exported(name), xml: name, attr: true, facets: f, doc: doc})
+	}
+	return nil
+}
+
+// addField adds f to t. An element found more than once, eg. in two branches of a choice, is a single field.
+func (m *model) addField(t *goType, f *goField) {
+	for _, g := range t.fields {
+		if g.name == f.name {
+			g.slice = g.slice || f.slice
+			g.choice = g.choice && f.choice
+			return
+		}
+	}
+	t.fields = append(t.fields, f)
+}
+
+// simpleFacets returns the maxLength and enumeration facets of a simple type, or of the restriction
+// of a simple content, with those of its base types.
+func (m *model) simpleFacets(st *node) (facets, error) {
+	var f facets
+	if st == nil {
+		return f, nil
+	}
+	r := st
+	if st.XMLName.Local == "simpleType" {
+		if r = st.child("restriction"); r == nil {
+			return f, nil // list or union
+		}
+	}
+	if n := m.defs["simpleType"][local(r.attr("base"))]; n != nil && n != st {
+		base, err := m.simpleFacets(n)
+		if err != nil {
+			return f, err
+		}
+		f = base
+	}
+	if c := r.child("maxLength"); c != nil {
+		v, err := strconv.Atoi(c.attr("value"))
+		if err != nil {
+			return f, fmt.Errorf("maxLength %q: %v", c.attr("value"), err)
+		}
+		f.maxLength = v
+	}
+	if values := r.children("enumeration"); len(values) > 0 {
+		f.enum = nil
+		for _, c := range values {
+			f.enum = append(f.enum, c.attr("value"))
+		}
+	}
+	return f, nil
+}
+
+// addEnum records the values of an enumerated attribute or element, merged with those of the same name.
+func (m *model) addEnum(name string, attr bool, s *schema, values []string) {
+	e := m.enums[name]
+	if e == nil {
+		e = &enum{name: name, attr: attr, schema: s}
+		m.enums[name] = e
+	}
+	for _, v := range values {
+		if !slices.Contains(e.values, v) {
+			e.values = append(e.values, v)
+		}
+	}
+}
+
+// exported returns the exported Go name of an XML name, eg. XAL of xAL or PostTown of post-town.
+func exported(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// snake returns the json name of a Go name, eg. attr_valid_from_date of AttrValidFromDate.
+func snake(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && next) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// xsdNamespace is the namespace of the elements of XML schemas, eg. xs:element.
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// node - An element of an XML schema, eg. xs:element, with its attributes and children in document order.
+type node struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []*node    `xml:",any"`
+	Text     string     `xml:",chardata"`
+}
+
+// attr returns the value of the unqualified attribute, eg. name.
+func (n *node) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// children returns the XML schema children of n with one of the names, eg. element, in document order.
+func (n *node) children(names ...string) []*node {
+	var nodes []*node
+	for _, c := range n.Children {
+		if c.XMLName.Space != xsdNamespace {
+			continue
+		}
+		for _, name := range names {
+			if c.XMLName.Local == name {
+				nodes = append(nodes, c)
+				break
+			}
+		}
+	}
+	return nodes
+}
+
+// child returns the first XML schema child of n with the name, or nil.
+func (n *node) child(name string) *node {
+	if c := n.children(name); len(c) > 0 {
+		return c[0]
+	}
+	return nil
+}
+
+// maxOccurs returns the maxOccurs of a particle, -1 for unbounded.
+func (n *node) maxOccurs() int {
+	switch v := n.attr("maxOccurs"); v {
+	case "":
+		return 1
+	case "unbounded":
+		return -1
+	default:
+		max, _ := strconv.Atoi(v)
+		return max
+	}
+}
+
+// repeats reports whether the particle can occur more than once.
+func (n *node) repeats() bool {
+	return n.maxOccurs() != 1 && n.maxOccurs() != 0
+}
+
+// documentation returns the text of the xs:documentation of the annotation of n, on a single line.
+func (n *node) documentation() string {
+	ann := n.child("annotation")
+	if ann == nil {
+		return ""
+	}
+	var words []string
+	for _, d := range ann.children("documentation") {
+		words = append(words, strings.Fields(d.Text)...)
+	}
+	return strings.Join(words, " ")
+}
+
+// schema - An XML schema file.
+type schema struct {
+	file string
+	root *node
+}
+
+// loadSchema reads the XML schema file.
+func loadSchema(file string) (*schema, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var root node
+	if err := xml.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	if root.XMLName.Space != xsdNamespace || root.XMLName.Local != "schema" {
+		return nil, fmt.Errorf("%s: not an XML schema", file)
+	}
+	return &schema{file: file, root: &root}, nil
+}
+
+// local returns the local part of a qualified name, eg. AddressLine of xal:AddressLine.
+func local(qname string) string {
+	if _, name, ok := strings.Cut(qname, ":"); ok {
+		return name
+	}
+	return qname
+}
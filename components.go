@@ -0,0 +1,118 @@
+package xal
+
+import "strconv"
+
+// Component - Names a component of an address, independently of the branch of the tree it is stored in.
+//
+// Components are the keys of a Flat address, see Flatten.
+type Component string
+
+// Components of an address, in the order they are listed by FlatComponents.
+const (
+	ComponentAddressType   Component = "address_type"   // AddressDetails AttrAddressType
+	ComponentCurrentStatus Component = "current_status" // AddressDetails AttrCurrentStatus
+	ComponentUsage         Component = "usage"          // AddressDetails AttrUsage
+	ComponentValidFrom     Component = "valid_from"     // AddressDetails AttrValidFromDate
+	ComponentValidTo       Component = "valid_to"       // AddressDetails AttrValidToDate
+
+	ComponentCountryCode             Component = "country_code"              // CountryNameCode
+	ComponentCountryName             Component = "country_name"              // CountryName
+	ComponentAdminArea               Component = "admin_area"                // AdministrativeAreaName
+	ComponentAdminAreaType           Component = "admin_area_type"           // AdministrativeArea AttrType
+	ComponentLocality                Component = "locality"                  // LocalityName
+	ComponentLocalityType            Component = "locality_type"             // Locality AttrType
+	ComponentDependentLocality       Component = "dependent_locality"        // DependentLocalityName
+	ComponentDependentLocalityNumber Component = "dependent_locality_number" // DependentLocalityNumber
+
+	ComponentStreetName          Component = "street_name"           // ThoroughfareName
+	ComponentStreetLeadingType   Component = "street_leading_type"   // ThoroughfareLeadingType
+	ComponentStreetType          Component = "street_type"           // ThoroughfareTrailingType
+	ComponentStreetPreDirection  Component = "street_pre_direction"  // ThoroughfarePreDirection
+	ComponentStreetPostDirection Component = "street_post_direction" // ThoroughfarePostDirection
+	ComponentDependentStreetName Component = "dependent_street_name" // DependentThoroughfare ThoroughfareName
+
+	ComponentHouseNumber       Component = "house_number"        // PremiseNumber, or ThoroughfareNumber
+	ComponentHouseNumberSuffix Component = "house_number_suffix" // PremiseNumberSuffix, or ThoroughfareNumberSuffix
+	ComponentPremiseName       Component = "premise_name"        // PremiseName
+	ComponentBuildingName      Component = "building_name"       // BuildingName of the premise
+	ComponentUnitType          Component = "unit_type"           // SubPremise AttrType
+	ComponentUnit              Component = "unit"                // SubPremiseNumber
+	ComponentUnitName          Component = "unit_name"           // SubPremiseName
+
+	ComponentPostalCode          Component = "postal_code"           // PostalCodeNumber
+	ComponentPostalCodeExtension Component = "postal_code_extension" // PostalCodeNumberExtension
+	ComponentPostTown            Component = "post_town"             // PostTownName of the postal code
+	ComponentSortingCode         Component = "sorting_code"          // SortingCode of the PostalServiceElements
+	ComponentPostBox             Component = "post_box"              // PostBoxNumber
+	ComponentPostBoxType         Component = "post_box_type"         // PostBox AttrType
+	ComponentPostOffice          Component = "post_office"           // PostOfficeName
+	ComponentLargeMailUser       Component = "large_mail_user"       // LargeMailUserName
+)
+
+// FlatComponents lists the components of the flat vocabulary, in a stable order.
+// Address lines are not listed, their components are named by AddressLineComponent.
+var FlatComponents = []Component{
+	ComponentAddressType, ComponentCurrentStatus, ComponentUsage, ComponentValidFrom, ComponentValidTo,
+	ComponentCountryCode, ComponentCountryName, ComponentAdminArea, ComponentAdminAreaType,
+	ComponentLocality, ComponentLocalityType, ComponentDependentLocality, ComponentDependentLocalityNumber,
+	ComponentStreetName, ComponentStreetLeadingType, ComponentStreetType,
+	ComponentStreetPreDirection, ComponentStreetPostDirection, ComponentDependentStreetName,
+	ComponentHouseNumber, ComponentHouseNumberSuffix, ComponentPremiseName, ComponentBuildingName,
+	ComponentUnitType, ComponentUnit, ComponentUnitName,
+	ComponentPostalCode, ComponentPostalCodeExtension, ComponentPostTown, ComponentSortingCode, ComponentPostBox, ComponentPostBoxType,
+	ComponentPostOffice, ComponentLargeMailUser,
+}
+
+// AddressLineComponent returns the component of the n-th address line, counting from 1, eg. address_line_1.
+func AddressLineComponent(n int) Component {
+	return Component("address_line_" + strconv.Itoa(n))
+}
+
+// component returns the text of c, or "" when a does not carry it.
+//
+// Unlike in a Flat address, house numbers include their suffix, eg. 12A, and units
+// combine the names and numbers of all the sub-premises, eg. "4 B".
+func (a *AddressDetails) component(c Component) string {
+	switch c {
+	case ComponentCountryCode:
+		return a.CountryCode()
+	case ComponentAdminArea:
+		return a.AdminArea()
+	case ComponentLocality:
+		return a.LocalityName()
+	case ComponentDependentLocality:
+		return a.dependentLocality().name()
+	case ComponentDependentLocalityNumber:
+		if dl := a.dependentLocality(); dl != nil && dl.DependentLocalityNumber != nil {
+			return dl.DependentLocalityNumber.Text
+		}
+	case ComponentStreetName:
+		return a.StreetName()
+	case ComponentDependentStreetName:
+		if t := a.thoroughfare(); t != nil && t.DependentThoroughfare != nil && len(t.DependentThoroughfare.ThoroughfareName) > 0 {
+			return t.DependentThoroughfare.ThoroughfareName[0].Text
+		}
+	case ComponentHouseNumber:
+		return a.HouseNumber()
+	case ComponentPremiseName:
+		if p := a.premise(); p != nil && len(p.PremiseName) > 0 {
+			return p.PremiseName[0].Text
+		}
+	case ComponentUnit:
+		return a.subPremise().unit()
+	case ComponentPostalCode:
+		return a.PostalCode()
+	case ComponentPostBox:
+		if pb := a.postBox(); pb != nil && pb.PostBoxNumber != nil {
+			return pb.PostBoxNumber.Text
+		}
+	case ComponentPostOffice:
+		if po := a.postOffice(); po != nil && len(po.PostOfficeName) > 0 {
+			return po.PostOfficeName[0].Text
+		}
+	default:
+		f, _ := a.Flatten()
+		return f[c]
+	}
+	return ""
+}
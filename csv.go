@@ -0,0 +1,341 @@
+package xal
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCSVMapping is returned for a mapping that names unknown components, or columns missing from the file.
+var ErrInvalidCSVMapping = errors.New("xal: invalid CSV mapping")
+
+// CSVMapping - Maps the columns of a CSV file to the components of an address, eg.
+//
+//	CSVMapping{"Street": AddressLineComponent(1), "City": ComponentLocality, "Zip": ComponentPostalCode}
+//
+// The components are those of FlatComponents and AddressLineComponent, see Flatten.
+// A row holds one address per mapping, eg. a billing and a shipping address.
+type CSVMapping map[string]Component
+
+// CSVError - Reports a row of a CSV file that could not be read, or that holds invalid addresses.
+type CSVError struct {
+	Line int // Line of the row in the file, counting from 1
+	Err  error
+}
+
+func (e *CSVError) Error() string {
+	return fmt.Sprintf("%v (line %d)", e.Err, e.Line)
+}
+
+func (e *CSVError) Unwrap() error { return e.Err }
+
+// csvColumn - A column of a mapping, in the order columns are written.
+type csvColumn struct {
+	name      string
+	component Component
+	index     int // Position of the column in the file, set by the reader
+}
+
+// csvColumns checks the mappings and returns their columns, in the order of the components of each mapping.
+func csvColumns(mappings []CSVMapping) ([][]*csvColumn, error) {
+	known := map[Component]bool{}
+	for _, c := range FlatComponents {
+		known[c] = true
+	}
+	order := func(c Component) int {
+		if n, ok := addressLineNumber(c); ok {
+			return len(FlatComponents) + n
+		}
+		return slices.Index(FlatComponents, c)
+	}
+	seen := map[string]bool{}
+	blocks := make([][]*csvColumn, len(mappings))
+	for i, m := range mappings {
+		mapped := map[Component]string{}
+		for name, c := range m {
+			if _, ok := addressLineNumber(c); !ok && !known[c] {
+				return nil, fmt.Errorf("%w: column %q: unknown component %s", ErrInvalidCSVMapping, name, c)
+			}
+			if other, ok := mapped[c]; ok {
+				return nil, fmt.Errorf("%w: columns %q and %q both hold %s", ErrInvalidCSVMapping, min(name, other), max(name, other), c)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("%w: column %q is mapped twice", ErrInvalidCSVMapping, name)
+			}
+			mapped[c] = name
+			seen[name] = true
+			blocks[i] = append(blocks[i], &csvColumn{name: name, component: c})
+		}
+		sort.Slice(blocks[i], func(a, b int) bool {
+			return order(blocks[i][a].component) < order(blocks[i][b].component)
+		})
+	}
+	return blocks, nil
+}
+
+// CSVReader - Reads addresses from a CSV file with a header row, one row at a time.
+type CSVReader struct {
+	r        *csv.Reader
+	mappings []CSVMapping
+	blocks   [][]*csvColumn
+	line     int
+	err      error // Sticky error of the header
+}
+
+// NewCSVReader returns a reader of the rows of r, holding one address per mapping.
+// Columns of the file that no mapping names are ignored.
+func NewCSVReader(r io.Reader, mappings ...CSVMapping) *CSVReader {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	return &CSVReader{r: cr, mappings: mappings}
+}
+
+// Read returns the addresses of the next row, one per mapping, with nil for a mapping whose columns are all empty.
+// Values are trimmed of surrounding spaces and the addresses are built in BranchCountry, see Unflatten.
+//
+// At the end of the file, Read returns io.EOF. A row that cannot be read, eg. of the wrong number of fields,
+// or whose addresses do not validate, is returned as a *CSVError, and the next call reads the next row.
+// The addresses of invalid rows are still returned, the error wrapping ValidationErrors whose paths
+// start with the index of the address in the row, eg. /1/locality/locality_name/0/text.
+// A mapping that does not match the header is an ErrInvalidCSVMapping, returned by every call.
+func (r *CSVReader) Read() ([]*AddressDetails, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.blocks == nil {
+		if r.err = r.readHeader(); r.err != nil {
+			return nil, r.err
+		}
+	}
+	record, err := r.r.Read()
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			r.line = pe.StartLine
+		}
+		return nil, &CSVError{Line: r.line, Err: err}
+	}
+	r.line, _ = r.r.FieldPos(0)
+	addrs := make([]*AddressDetails, len(r.blocks))
+	var errs ValidationErrors
+	for i, columns := range r.blocks {
+		flat := Flat{}
+		for _, c := range columns {
+			if c.index < len(record) {
+				if v := strings.TrimSpace(record[c.index]); v != "" {
+					flat[c.component] = v
+				}
+			}
+		}
+		if len(flat) == 0 {
+			continue
+		}
+		addrs[i] = flat.csvAddress()
+		if verr := addrs[i].Validate(); verr != nil {
+			for _, e := range verr.(ValidationErrors) {
+				errs = append(errs, &ValidationError{Path: append(Path{strconv.Itoa(i)}, e.Path...), Field: e.Field, Reason: e.Reason})
+			}
+		}
+	}
+	switch {
+	case err != nil:
+		return addrs, &CSVError{Line: r.line, Err: err}
+	case len(errs) > 0:
+		return addrs, &CSVError{Line: r.line, Err: errs}
+	}
+	return addrs, nil
+}
+
+// Line returns the line of the row last returned by Read, counting from 1.
+func (r *CSVReader) Line() int {
+	return r.line
+}
+
+// readHeader reads the header row and locates the columns of the mappings.
+func (r *CSVReader) readHeader() error {
+	blocks, err := csvColumns(r.mappings)
+	if err != nil {
+		return err
+	}
+	header, err := r.r.Read()
+	if err == io.EOF {
+		return &CSVError{Line: 1, Err: fmt.Errorf("%w: no header row", ErrInvalidCSVMapping)}
+	}
+	if err != nil {
+		line := 1
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			line = pe.StartLine
+		}
+		return &CSVError{Line: line, Err: err}
+	}
+	r.line, _ = r.r.FieldPos(0) // Blank lines before the header are skipped
+	// The header is the number of fields expected of every row.
+	r.r.FieldsPerRecord = len(header)
+	index := map[string]int{}
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // Byte order mark of spreadsheet exports
+		}
+		if _, ok := index[strings.TrimSpace(name)]; !ok {
+			index[strings.TrimSpace(name)] = i
+		}
+	}
+	var missing []string
+	for _, columns := range blocks {
+		for _, c := range columns {
+			i, ok := index[c.name]
+			if !ok {
+				missing = append(missing, strconv.Quote(c.name))
+			}
+			c.index = i
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return &CSVError{Line: r.line, Err: fmt.Errorf("%w: missing columns %s", ErrInvalidCSVMapping, strings.Join(missing, ", "))}
+	}
+	r.blocks = blocks
+	return nil
+}
+
+// csvAddress builds the address of the components read from a row. The address lines of an address
+// that also has structured components, eg. a street column next to city and postal code columns,
+// are parsed into the street components that have no column of their own, see parseStreetLines.
+func (f Flat) csvAddress() *AddressDetails {
+	var numbers []int
+	structured := false
+	for c := range f {
+		switch n, ok := addressLineNumber(c); {
+		case ok:
+			numbers = append(numbers, n)
+		case !csvAddressComponents[c]:
+			structured = true
+		}
+	}
+	if !structured || len(numbers) == 0 {
+		return f.build()
+	}
+	sort.Ints(numbers)
+	lines := make([]string, len(numbers))
+	for i, n := range numbers {
+		lines[i] = f[AddressLineComponent(n)]
+		delete(f, AddressLineComponent(n))
+	}
+	country := f[ComponentCountryCode]
+	if country == "" {
+		country = f[ComponentCountryName]
+	}
+	for c, v := range parseStreetLines(lines, country) {
+		if f[c] == "" {
+			f[c] = v
+		}
+	}
+	return f.build()
+}
+
+// csvAddressComponents are the components of the address itself, which do not make it structured.
+var csvAddressComponents = map[Component]bool{
+	ComponentAddressType: true, ComponentCurrentStatus: true, ComponentUsage: true, ComponentValidFrom: true, ComponentValidTo: true,
+}
+
+// csvValues returns the values of the columns, and the components they carry. Addresses without
+// address lines fill the address line columns of mappings without street columns with their street lines,
+// eg. 1 Market St and Apt 4, the last column holding the remaining lines, see streetLines.
+func (f Flat) csvValues(columns []*csvColumn) (values []string, carried []Component) {
+	var lineColumns []int
+	streetColumns := false
+	for i, c := range columns {
+		if _, ok := addressLineNumber(c.component); ok {
+			lineColumns = append(lineColumns, i)
+		}
+		streetColumns = streetColumns || slices.Contains(streetComponents, c.component)
+		values = append(values, f[c.component])
+		carried = append(carried, c.component)
+	}
+	if f[AddressLineComponent(1)] != "" || streetColumns || len(lineColumns) == 0 {
+		return values, carried
+	}
+	lines, linesCarried := f.streetLines()
+	for k, i := range lineColumns {
+		switch {
+		case k >= len(lines):
+		case k == len(lineColumns)-1:
+			values[i] = strings.Join(lines[k:], ", ")
+		default:
+			values[i] = lines[k]
+		}
+	}
+	return values, append(carried, linesCarried...)
+}
+
+// CSVWriter - Writes addresses to a CSV file with a header row, one row at a time.
+//
+// The columns are those of the mappings, in the order of the mappings and of the components
+// of each mapping, see FlatComponents. Rows are buffered, call Flush once done.
+type CSVWriter struct {
+	w        *csv.Writer
+	mappings []CSVMapping
+	blocks   [][]*csvColumn
+}
+
+// NewCSVWriter returns a writer of rows holding one address per mapping.
+func NewCSVWriter(w io.Writer, mappings ...CSVMapping) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), mappings: mappings}
+}
+
+// Write writes a row of the addresses, the i-th address to the columns of the i-th mapping.
+// The header row is written first. A nil address, or a missing one, leaves its columns empty.
+//
+// The report lists the values of the addresses that no column holds, as JSON Pointers starting
+// with the index of the address, eg. /0/postal_service_elements/barcode/text, see Flatten.
+func (w *CSVWriter) Write(addrs ...*AddressDetails) (Report, error) {
+	if len(addrs) > len(w.mappings) {
+		return Report{}, fmt.Errorf("%w: %d addresses for %d mappings", ErrInvalidCSVMapping, len(addrs), len(w.mappings))
+	}
+	if w.blocks == nil {
+		blocks, err := csvColumns(w.mappings)
+		if err != nil {
+			return Report{}, err
+		}
+		var header []string
+		for _, columns := range blocks {
+			for _, c := range columns {
+				header = append(header, c.name)
+			}
+		}
+		if err := w.w.Write(header); err != nil {
+			return Report{}, err
+		}
+		w.blocks = blocks
+	}
+	var r Report
+	var record []string
+	for i, columns := range w.blocks {
+		var a *AddressDetails
+		if i < len(addrs) {
+			a = addrs[i]
+		}
+		f := newFlattener(a)
+		values, carried := f.flat.csvValues(columns)
+		record = append(record, values...)
+		if a != nil {
+			r = r.merge(f.dropped(carried...), Path{strconv.Itoa(i)})
+		}
+	}
+	return r, w.w.Write(record)
+}
+
+// Flush writes the buffered rows, and returns any error of the underlying writer.
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
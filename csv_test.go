@@ -0,0 +1,89 @@
+package xal
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCSVReaderLines(t *testing.T) {
+	type row struct {
+		line int
+		err  string // part of the error message of the row, if it fails
+	}
+	mapping := CSVMapping{"City": ComponentLocality, "Usage": ComponentUsage}
+	tests := []struct {
+		name string
+		csv  string
+		want []row
+	}{
+		{"rows", "City,Usage\nOslo,\nBergen,\n", []row{{2, ""}, {3, ""}}},
+		{"blank lines", "\nCity,Usage\n\nOslo,\n\n\nBergen,\n", []row{{4, ""}, {7, ""}}},
+		{"quoted new lines", "City,Usage\n\"Oslo\nNorway\",\nBergen,\n", []row{{2, ""}, {4, ""}}},
+		{"wrong number of fields", "City,Usage\nOslo\nBergen,\n", []row{{2, "wrong number of fields"}, {3, ""}}},
+		{"bare quote", "City,Usage\nOs\"lo,\nBergen,\n", []row{{2, `bare "`}, {3, ""}}},
+		{"invalid address", "City,Usage\nOslo,Residential\nBergen,\n", []row{{2, "maximum"}, {3, ""}}},
+		{"header only", "City,Usage\n", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewCSVReader(strings.NewReader(tt.csv), mapping)
+			var got []row
+			for {
+				_, err := r.Read()
+				if err == io.EOF {
+					break
+				}
+				got = append(got, row{line: r.Line()})
+				if err == nil {
+					continue
+				}
+				var ce *CSVError
+				if !errors.As(err, &ce) {
+					t.Fatal(err)
+				}
+				if ce.Line != r.Line() {
+					t.Errorf("CSVError line %d, Line() %d", ce.Line, r.Line())
+				}
+				got[len(got)-1].err = err.Error()
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("read %v, want %v", got, tt.want)
+			}
+			for i, w := range tt.want {
+				g := got[i]
+				if g.line != w.line || (w.err == "") != (g.err == "") || !strings.Contains(g.err, w.err) {
+					t.Errorf("row %d = line %d, error %q, want line %d, error containing %q", i, g.line, g.err, w.line, w.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCSVReaderHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		line int
+		want error
+	}{
+		{"missing column", "City\nOslo\n", 1, ErrInvalidCSVMapping},
+		{"no header", "", 1, ErrInvalidCSVMapping},
+		{"header after blank lines", "\n\nTown\nOslo\n", 3, ErrInvalidCSVMapping},
+		{"invalid header", "City,\"Zip\n", 1, csv.ErrQuote},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewCSVReader(strings.NewReader(tt.csv), CSVMapping{"City": ComponentLocality, "Zip": ComponentPostalCode})
+			for range 2 {
+				_, err := r.Read()
+				var ce *CSVError
+				if !errors.Is(err, tt.want) || !errors.As(err, &ce) || ce.Line != tt.line {
+					t.Fatalf("Read() error = %v, want a CSVError of line %d wrapping %v", err, tt.line, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+/*Package xal defines defines structs that conform to the OASIS xAL spec
+
+Specification
+
+You can find and download the spec here: https://www.oasis-open.org/committees/ciq/download.html
+
+The entry point for a XAL address is the top level XAL struct.
+
+The types of xal.go and xnl.go are generated by cmd/xalgen from the schemas of the schema directory,
+see go generate. The schemas are those of the spec; the length limits the package adds on top of them
+are listed in schema/limits.txt.
+
+Generating the types from the complete schemas changed their JSON encoding:
+the elements the spec lets repeat are arrays, eg. country_name_code, thoroughfare_name and premise_number,
+ThoroughfareName is an object with a text member like the other elements of mixed content,
+and the Code attribute is attr_code everywhere. JSON documents and JSON Patch paths of earlier versions
+have to be migrated, eg. /country/country_name_code/text is now /country/country_name_code/0/text,
+and their fingerprints recomputed, see FingerprintVersion.
+
+Fields annotated with Attr are what used to be attribute fields in the XML formatted specification.
+The xml tags follow the element and attribute names of the XML schema, see NewXMLDecoder and NewXMLEncoder.
+
+Trailing field comments carry constraints of the spec: maxLength=N is the maximum length of the value,
+enum=A|B lists the values allowed, and choice marks the fields of a type of which at most one can be set.
+They are checked by Validate, and by the JSON Schema of the JSON encoding, xal.schema.json.
+*/
+package xal
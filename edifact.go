@@ -0,0 +1,333 @@
+package xal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidEDIFACT is returned when an EDIFACT segment cannot be parsed.
+var ErrInvalidEDIFACT = errors.New("xal: invalid EDIFACT segment")
+
+// EDIFACTNAD - An UN/EDIFACT NAD name and address segment, as defined by the directories D.01B and later, eg.
+//
+//	NAD+DP+5412345000013::9++Acme Corp+1 Market St:Suite 100+San Francisco+CA+94105+US'
+//
+// The fields hold the values without their release characters. Trailing comments give the data element
+// and its length, eg. an..35 for up to 35 alphanumeric characters.
+type EDIFACTNAD struct {
+	PartyQualifier       string   // 3035 an..3, mandatory, eg. BY buyer or DP delivery party
+	PartyID              string   // C082 3039 an..35, eg. a GLN
+	PartyIDCodeList      string   // C082 1131 an..17
+	PartyIDAgency        string   // C082 3055 an..3, eg. 9 for GS1
+	NameAndAddress       []string // C058 3124 an..35, up to 5 lines of an unstructured name and address
+	PartyName            []string // C080 3036 an..35, up to 5 lines
+	PartyNameFormat      string   // C080 3045 an..3
+	Street               []string // C059 3042 an..35, up to 4 lines
+	City                 string   // 3164 an..35
+	CountrySubEntity     string   // C819 3229 an..9, eg. CA
+	CountrySubEntityName string   // C819 3228 an..70
+	PostalCode           string   // 3251 an..17
+	Country              string   // 3207 an..3, ISO 3166-1 alpha-2 code
+}
+
+// edifactEscaper escapes the default UNA delimiters +:' and the release character ?.
+var edifactEscaper = strings.NewReplacer("?", "??", "+", "?+", ":", "?:", "'", "?'")
+
+// Validate checks the segment against the lengths and the repetitions of its data elements.
+//
+// It returns nil or a ValidationErrors whose paths name the composite and the data element, eg. /NAD/C080/3036/0
+// for the first line of the party name, so that values too long for the segment are reported rather than truncated.
+func (n *EDIFACTNAD) Validate() error {
+	var errs ValidationErrors
+	nad := Path{"NAD"}
+	if n.PartyQualifier == "" {
+		errs = append(errs, &ValidationError{Path: nad, Field: "3035", Reason: "party function code qualifier is required"})
+	}
+	errs = errs.checkLength(nad, "3035", n.PartyQualifier, 3)
+	errs = errs.checkLength(nad.child("C082"), "3039", n.PartyID, 35)
+	errs = errs.checkLength(nad.child("C082"), "1131", n.PartyIDCodeList, 17)
+	errs = errs.checkLength(nad.child("C082"), "3055", n.PartyIDAgency, 3)
+	for _, c := range []struct {
+		composite, element string
+		lines              []string
+		max                int
+	}{{"C058", "3124", n.NameAndAddress, 5}, {"C080", "3036", n.PartyName, 5}, {"C059", "3042", n.Street, 4}} {
+		if len(c.lines) > c.max {
+			errs = append(errs, &ValidationError{
+				Path:   nad.child(c.composite),
+				Field:  c.element,
+				Reason: fmt.Sprintf("%d lines, the maximum is %d", len(c.lines), c.max),
+			})
+		}
+		for i, l := range c.lines {
+			errs = errs.checkLength(nad.child(c.composite, c.element), strconv.Itoa(i), l, 35)
+		}
+	}
+	errs = errs.checkLength(nad.child("C080"), "3045", n.PartyNameFormat, 3)
+	errs = errs.checkLength(nad, "3164", n.City, 35)
+	errs = errs.checkLength(nad.child("C819"), "3229", n.CountrySubEntity, 9)
+	errs = errs.checkLength(nad.child("C819"), "3228", n.CountrySubEntityName, 70)
+	errs = errs.checkLength(nad, "3251", n.PostalCode, 17)
+	errs = errs.checkLength(nad, "3207", n.Country, 3)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Segment validates the segment and encodes it with the default UNA delimiters, without its trailing
+// empty data elements and components, eg. NAD+DP++++1 Market St+San Francisco++94105+US'.
+func (n *EDIFACTNAD) Segment() (string, error) {
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	// The empty values are trimmed before joining, as trimming the delimiters would also trim released ones, eg. a?+.
+	composite := func(values ...string) string {
+		values = trimEmpty(values)
+		escaped := make([]string, len(values))
+		for i, v := range values {
+			escaped[i] = edifactEscaper.Replace(v)
+		}
+		return strings.Join(escaped, ":")
+	}
+	name := append(slices.Clone(n.PartyName), make([]string, 5-len(n.PartyName))...)
+	elems := []string{
+		"NAD",
+		composite(n.PartyQualifier),
+		composite(n.PartyID, n.PartyIDCodeList, n.PartyIDAgency),
+		composite(n.NameAndAddress...),
+		composite(append(name, n.PartyNameFormat)...),
+		composite(n.Street...),
+		composite(n.City),
+		composite(n.CountrySubEntity, "", "", n.CountrySubEntityName),
+		composite(n.PostalCode),
+		composite(n.Country),
+	}
+	return strings.Join(trimEmpty(elems), "+") + "'", nil
+}
+
+// trimEmpty returns values without its trailing empty values.
+func trimEmpty(values []string) []string {
+	for len(values) > 0 && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+	return values
+}
+
+// ParseNAD parses a NAD segment with the default UNA delimiters, eg. NAD+BY+++Acme Corp+1 Market St+San Francisco++94105+US',
+// and validates it, see EDIFACTNAD.Validate. The segment terminator is optional.
+func ParseNAD(segment string) (*EDIFACTNAD, error) {
+	segment = strings.TrimSpace(segment)
+	if s, ok := strings.CutSuffix(segment, "'"); ok && !released(segment, len(segment)-1) {
+		segment = s
+	}
+	elems := splitReleased(segment, '+')
+	if elems[0] != "NAD" {
+		return nil, fmt.Errorf("%w: segment is %s, not NAD", ErrInvalidEDIFACT, elems[0])
+	}
+	if len(elems) > 10 {
+		return nil, fmt.Errorf("%w: NAD has %d data elements, the maximum is 9", ErrInvalidEDIFACT, len(elems)-1)
+	}
+	elems = append(elems, make([]string, 10-len(elems))...)
+	components := func(i, n int) []string {
+		comps := splitReleased(elems[i], ':')
+		for j, c := range comps {
+			comps[j] = unreleaseEDIFACT(c)
+		}
+		return append(comps, make([]string, max(n-len(comps), 0))...)
+	}
+	lines := func(i int) []string {
+		return nonEmpty(components(i, 0))
+	}
+	party, name, subEntity := components(2, 3), components(4, 6), components(7, 4)
+	if len(name) > 6 {
+		return nil, fmt.Errorf("%w: C080 has %d components, the maximum is 6", ErrInvalidEDIFACT, len(name))
+	}
+	n := &EDIFACTNAD{
+		PartyQualifier:       components(1, 1)[0],
+		PartyID:              party[0],
+		PartyIDCodeList:      party[1],
+		PartyIDAgency:        party[2],
+		NameAndAddress:       lines(3),
+		PartyName:            nonEmpty(name[:len(name)-1]),
+		PartyNameFormat:      name[len(name)-1],
+		Street:               lines(5),
+		City:                 components(6, 1)[0],
+		CountrySubEntity:     subEntity[0],
+		CountrySubEntityName: subEntity[3],
+		PostalCode:           components(8, 1)[0],
+		Country:              components(9, 1)[0],
+	}
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// ToNAD converts the name and the address of a party to a NAD segment with the party function code qualifier,
+// eg. DP for the delivery party, and validates it, see EDIFACTNAD.Validate.
+//
+// The name goes to the party name, see NameDetails.Lines. The premise, street, house number, unit and post box
+// become the street lines, see ToSchemaOrg, and the administrative area the country sub-entity, or its name when
+// longer than 9 characters. Addresses made of address lines go to the unstructured name and address,
+// but for a line of type Country which becomes the country.
+//
+// The report lists what the segment cannot carry, eg. the dependent locality and the name of the country.
+// On error, the segment is nil and the report still lists the losses, eg. an address too long for the street lines.
+func ToNAD(qualifier string, name *NameDetails, a *AddressDetails) (*EDIFACTNAD, Report, error) {
+	n := &EDIFACTNAD{PartyQualifier: qualifier, PartyName: name.Lines()}
+	var r Report
+	if a != nil {
+		f := newFlattener(a)
+		flat := f.flat
+		n.City = flat[ComponentLocality]
+		n.PostalCode = joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-")
+		n.Country = flat[ComponentCountryCode]
+		if area := flat[ComponentAdminArea]; len([]rune(area)) > 9 {
+			n.CountrySubEntityName = area
+		} else {
+			n.CountrySubEntity = area
+		}
+		carried := []Component{
+			ComponentLocality, ComponentPostalCode, ComponentPostalCodeExtension, ComponentCountryCode, ComponentAdminArea,
+		}
+		if a.AddressLines != nil {
+			for i, l := range *a.AddressLines {
+				carried = append(carried, AddressLineComponent(i+1))
+				switch {
+				case l == nil || l.Text == "":
+				case strings.EqualFold(l.AttrType, "Country") && n.Country == "":
+					n.Country = strings.ToUpper(l.Text)
+					f.use(l, "AttrType")
+				default:
+					n.NameAndAddress = append(n.NameAndAddress, l.Text)
+				}
+			}
+		} else {
+			var c []Component
+			n.Street, c = flat.streetLines()
+			carried = append(carried, c...)
+			if box := flat[ComponentPostBox]; box != "" {
+				n.Street = append(n.Street, "PO Box "+box)
+				carried = append(carried, ComponentPostBox)
+			}
+		}
+		r = f.dropped(carried...)
+	}
+	if err := n.Validate(); err != nil {
+		return nil, r, err
+	}
+	return n, r, nil
+}
+
+// FromNAD converts a NAD segment to the name and the address of the party, either of which is nil when empty.
+//
+// The party name becomes name lines. The street lines are parsed on a best-effort basis, see FromSchemaOrg,
+// a line such as PO Box 123 giving the post box, and the country sub-entity, or else its name, becomes
+// the administrative area. A segment with an unstructured name and address only is converted to address lines,
+// the country being kept in a last line of type Country.
+//
+// The report lists the data elements that were not converted, as JSON Pointers to the fields of the segment,
+// eg. /NameAndAddress when the segment also has a structured address. The party identification is not part of the
+// name nor of the address, and is never reported.
+func FromNAD(n *EDIFACTNAD) (*NameDetails, *AddressDetails, Report) {
+	if n == nil {
+		return nil, nil, Report{}
+	}
+	var r Report
+	name := NameFromLines(n.PartyName...)
+	if name != nil && n.PartyNameFormat != "" {
+		r.Dropped = append(r.Dropped, "/PartyNameFormat")
+	}
+	area := strings.TrimSpace(n.CountrySubEntity)
+	if area == "" {
+		area = strings.TrimSpace(n.CountrySubEntityName)
+	} else if n.CountrySubEntityName != "" {
+		r.Dropped = append(r.Dropped, "/CountrySubEntityName")
+	}
+	country := strings.ToUpper(strings.TrimSpace(n.Country))
+	structured := len(nonEmpty(n.Street)) > 0 || n.City != "" || area != "" || n.PostalCode != ""
+	var flat Flat
+	switch {
+	case structured:
+		var box string
+		lines := slices.DeleteFunc(nonEmpty(n.Street), func(l string) bool {
+			m := postBoxRE.FindStringSubmatch(l)
+			if m != nil && box == "" {
+				box = m[1]
+				return true
+			}
+			return false
+		})
+		flat = parseStreetLines(lines, country)
+		flat[ComponentPostBox] = box
+		flat[ComponentLocality] = strings.TrimSpace(n.City)
+		flat[ComponentAdminArea] = area
+		flat[ComponentPostalCode] = strings.TrimSpace(n.PostalCode)
+		flat[ComponentCountryCode] = country
+		if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && country == "US" {
+			flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+		}
+		if len(nonEmpty(n.NameAndAddress)) > 0 {
+			r.Dropped = append(r.Dropped, "/NameAndAddress")
+		}
+	case len(nonEmpty(n.NameAndAddress)) > 0 || country != "":
+		flat = Flat{}
+		for i, l := range nonEmpty(n.NameAndAddress) {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	default:
+		sort.Strings(r.Dropped)
+		return name, nil, r
+	}
+	a := flat.build()
+	if !structured && country != "" {
+		if a.AddressLines == nil {
+			a.AddressLines = &AddressLines{}
+		}
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: country})
+	}
+	sort.Strings(r.Dropped)
+	return name, a, r
+}
+
+// released reports whether the character at i of s is preceded by an odd number of release characters.
+func released(s string, i int) bool {
+	n := 0
+	for i--; i >= 0 && s[i] == '?'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// splitReleased splits s around the occurrences of sep that are not released with ?.
+func splitReleased(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '?':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unreleaseEDIFACT removes the release characters of s.
+func unreleaseEDIFACT(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '?' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
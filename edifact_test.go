@@ -0,0 +1,167 @@
+package xal
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNADSegment(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       *EDIFACTNAD
+		segment string
+	}{
+		{"party id and address", &EDIFACTNAD{
+			PartyQualifier: "DP", PartyID: "5412345000013", PartyIDAgency: "9", PartyName: []string{"Acme Corp"},
+			Street: []string{"1 Market St", "Suite 100"}, City: "San Francisco", CountrySubEntity: "CA",
+			PostalCode: "94105", Country: "US",
+		}, "NAD+DP+5412345000013::9++Acme Corp+1 Market St:Suite 100+San Francisco+CA+94105+US'"},
+		{"name format", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{"Acme"}, PartyNameFormat: "1"}, "NAD+BY+++Acme:::::1'"},
+		{"sub-entity name", &EDIFACTNAD{PartyQualifier: "BY", CountrySubEntityName: "Brandenburg", Country: "DE"}, "NAD+BY++++++:::Brandenburg++DE'"},
+		{"unstructured", &EDIFACTNAD{PartyQualifier: "BY", NameAndAddress: []string{"Acme Corp", "1 Market St"}}, "NAD+BY++Acme Corp:1 Market St'"},
+		{"delimiters", &EDIFACTNAD{
+			PartyQualifier: "BY", PartyName: []string{"Smith + Sons", "O'Brien: Ltd"}, Street: []string{"Why?"},
+		}, "NAD+BY+++Smith ?+ Sons:O?'Brien?: Ltd+Why??'"},
+		{"trailing delimiters", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{"Acme:"}, City: "Foo+"}, "NAD+BY+++Acme?:++Foo?+'"},
+		{"trailing release character", &EDIFACTNAD{PartyQualifier: "BY", City: "Foo?"}, "NAD+BY+++++Foo??'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.n.Segment()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.segment {
+				t.Errorf("Segment() = %s, want %s", got, tt.segment)
+			}
+			n, err := ParseNAD(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(n, tt.n) {
+				t.Errorf("ParseNAD(%s) = %+v, want %+v", got, n, tt.n)
+			}
+		})
+	}
+}
+
+func TestParseNAD(t *testing.T) {
+	tests := []struct {
+		name    string
+		segment string
+		want    *EDIFACTNAD
+		err     error
+	}{
+		{"without terminator", "NAD+BY+++Acme", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{"Acme"}}, nil},
+		{"released terminator", "NAD+BY+++O?'Brien?'", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{"O'Brien'"}}, nil},
+		{"released release character", "NAD+BY+++Why??'", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{"Why?"}}, nil},
+		{"not NAD", "LOC+11+DEHAM'", nil, ErrInvalidEDIFACT},
+		{"too many data elements", "NAD+BY+++Acme++++++X'", nil, ErrInvalidEDIFACT},
+		{"too many name components", "NAD+BY+++A:B:C:D:E:1:X'", nil, ErrInvalidEDIFACT},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNAD(tt.segment)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNADValidate(t *testing.T) {
+	long := strings.Repeat("x", 36)
+	tests := []struct {
+		name string
+		n    *EDIFACTNAD
+		want []string // paths of the errors
+	}{
+		{"valid", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{strings.Repeat("x", 35)}}, nil},
+		{"no qualifier", &EDIFACTNAD{}, []string{"/NAD/3035"}},
+		{"party name line too long", &EDIFACTNAD{PartyQualifier: "BY", PartyName: []string{"Acme", long}}, []string{"/NAD/C080/3036/1"}},
+		{"too many party name lines", &EDIFACTNAD{PartyQualifier: "BY", PartyName: make([]string, 6)}, []string{"/NAD/C080/3036"}},
+		{"too many street lines", &EDIFACTNAD{PartyQualifier: "BY", Street: make([]string, 5)}, []string{"/NAD/C059/3042"}},
+		{"party id too long", &EDIFACTNAD{PartyQualifier: "BY", PartyID: long}, []string{"/NAD/C082/3039"}},
+		{"sub-entity too long", &EDIFACTNAD{PartyQualifier: "BY", CountrySubEntity: "Brandenburg"}, []string{"/NAD/C819/3229"}},
+		{"postal code and country", &EDIFACTNAD{PartyQualifier: "BY", PostalCode: long, Country: "DEU1"}, []string{"/NAD/3251", "/NAD/3207"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorPaths(tt.n.Validate())
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("Validate() failed on %v, want %v", got, tt.want)
+			}
+			if _, err := tt.n.Segment(); (err != nil) != (tt.want != nil) {
+				t.Errorf("Segment() error %v", err)
+			}
+		})
+	}
+}
+
+func TestNADRoundTrip(t *testing.T) {
+	n := &EDIFACTNAD{
+		PartyQualifier: "DP", PartyName: []string{"Acme Corp"}, Street: []string{"1 Market St", "PO Box 12"},
+		City: "San Francisco", CountrySubEntity: "CA", PostalCode: "94105-1234", Country: "US",
+	}
+	name, a, r := FromNAD(n)
+	if len(r.Dropped) > 0 {
+		t.Fatalf("FromNAD dropped %v", r.Dropped)
+	}
+	got, r, err := ToNAD("DP", name, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Dropped) > 0 {
+		t.Fatalf("ToNAD dropped %v", r.Dropped)
+	}
+	if !reflect.DeepEqual(got, n) {
+		t.Errorf("got %+v, want %+v", got, n)
+	}
+}
+
+func TestNADReport(t *testing.T) {
+	n := &EDIFACTNAD{
+		PartyQualifier: "BY", PartyName: []string{"Acme"}, PartyNameFormat: "1",
+		NameAndAddress: []string{"Acme", "Berlin"}, City: "Berlin", CountrySubEntity: "BE",
+		CountrySubEntityName: "Berlin", Country: "DE",
+	}
+	_, a, r := FromNAD(n)
+	if got, want := strings.Join(r.Dropped, " "), "/CountrySubEntityName /NameAndAddress /PartyNameFormat"; got != want {
+		t.Errorf("FromNAD dropped %q, want %q", got, want)
+	}
+	if a == nil {
+		t.Fatal("FromNAD returned no address")
+	}
+
+	a = &AddressDetails{Country: &Country{
+		CountryNameCode: []*CountryNameCode{{Text: "DE"}},
+		CountryName:     []*CountryName{{Text: "Germany"}},
+		Locality:        &Locality{LocalityName: []*LocalityName{{Text: "Berlin"}}},
+	}}
+	got, r, err := ToNAD("BY", nil, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.City != "Berlin" || got.Country != "DE" {
+		t.Errorf("got %+v", got)
+	}
+	if len(r.Dropped) != 1 {
+		t.Errorf("ToNAD dropped %v, want the country name", r.Dropped)
+	}
+
+	a.Country.Locality.LocalityName[0].Text = strings.Repeat("x", 36)
+	if got, _, err := ToNAD("BY", nil, a); got != nil || err == nil {
+		t.Errorf("ToNAD of a locality too long returned %+v, %v", got, err)
+	}
+}
@@ -0,0 +1,246 @@
+package xal
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FHIRAddress - The Address data type of HL7 FHIR R4, with the JSON names of its elements.
+type FHIRAddress struct {
+	Use        string      `json:"use,omitempty"`  // home | work | temp | old | billing
+	Type       string      `json:"type,omitempty"` // postal | physical | both
+	Text       string      `json:"text,omitempty"` // Text representation of the address
+	Line       []string    `json:"line,omitempty"` // Street name, number, direction & P.O. Box etc.
+	City       string      `json:"city,omitempty"`
+	District   string      `json:"district,omitempty"` // District name, aka county
+	State      string      `json:"state,omitempty"`
+	PostalCode string      `json:"postalCode,omitempty"`
+	Country    string      `json:"country,omitempty"` // ISO 3166 2 or 3 letter code, or the name of the country
+	Period     *FHIRPeriod `json:"period,omitempty"`  // Time period when the address was/is in use
+}
+
+// FHIRPeriod - The Period data type of HL7 FHIR R4.
+type FHIRPeriod struct {
+	Start string `json:"start,omitempty"` // dateTime, eg. 2020-01-15 or 2020-01-15T09:30:00Z
+	End   string `json:"end,omitempty"`
+}
+
+// fhirUses maps the lower-cased AttrUsage of an address to a FHIR address use.
+var fhirUses = map[string]string{
+	"home": "home", "personal": "home", "private": "home",
+	"work": "work", "business": "work", "office": "work",
+	"temp": "temp", "temporary": "temp",
+	"old":  "old",
+	"bill": "billing", "billing": "billing",
+}
+
+// fhirAttrUsages maps a FHIR address use to AttrUsage. billing is shortened to Bill, as AttrUsage has a maxLength of 6.
+var fhirAttrUsages = map[string]string{"home": "Home", "work": "Work", "temp": "Temp", "old": "Old", "billing": "Bill"}
+
+// fhirTypes maps the lower-cased AttrAddressType of an address to a FHIR address type, and back with fhirAttrAddressTypes.
+var (
+	fhirTypes            = map[string]string{"postal": "postal", "physical": "physical", "both": "both"}
+	fhirAttrAddressTypes = map[string]string{"postal": "Postal", "physical": "Physical", "both": "Both"}
+)
+
+var (
+	// postBoxRE matches a post box line, eg. PO Box 123.
+	postBoxRE = regexp.MustCompile(`(?i)^(?:P\.?\s*O\.?\s*Box|Post\s+Office\s+Box)\s+(\S+)$`)
+	// dateRE matches the date at the start of a FHIR date or dateTime, eg. 2020, 2020-01 or 2020-01-15.
+	dateRE = regexp.MustCompile(`^\d{4}(?:-\d{2}(?:-\d{2})?)?`)
+)
+
+// ToFHIR converts the address to a FHIR Address.
+//
+// AttrUsage and AttrAddressType become the use and the type, eg. Home to home and Postal to postal,
+// and AttrValidFromDate and AttrValidToDate the period. The premise, street, house number, unit and post box
+// become the lines, see ToSchemaOrg, and the administrative area name of type District the district.
+// Addresses made of address lines keep their lines, but for a line of type Country which becomes the country.
+//
+// The report lists what the Address cannot carry, eg. the dependent locality and the uses FHIR does not define.
+func ToFHIR(a *AddressDetails) (*FHIRAddress, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	addr := &FHIRAddress{
+		City:       flat[ComponentLocality],
+		PostalCode: joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		Country:    flat[ComponentCountryCode],
+	}
+	carried := []Component{
+		ComponentLocality, ComponentAdminArea, ComponentPostalCode, ComponentPostalCodeExtension, ComponentCountryCode,
+	}
+	if addr.Country == "" {
+		addr.Country = flat[ComponentCountryName]
+		carried = append(carried, ComponentCountryName)
+	}
+	addr.State, addr.District = f.adminAreaNames()
+	if use, ok := fhirUses[strings.ToLower(flat[ComponentUsage])]; ok {
+		addr.Use = use
+		carried = append(carried, ComponentUsage)
+	}
+	if typ, ok := fhirTypes[strings.ToLower(flat[ComponentAddressType])]; ok {
+		addr.Type = typ
+		carried = append(carried, ComponentAddressType)
+	}
+	if from, to := flat[ComponentValidFrom], flat[ComponentValidTo]; from != "" || to != "" {
+		addr.Period = &FHIRPeriod{Start: from, End: to}
+		carried = append(carried, ComponentValidFrom, ComponentValidTo)
+	}
+	if a.AddressLines != nil {
+		for i, l := range *a.AddressLines {
+			carried = append(carried, AddressLineComponent(i+1))
+			switch {
+			case l == nil || l.Text == "":
+			case strings.EqualFold(l.AttrType, "Country") && addr.Country == "":
+				addr.Country = l.Text
+				f.use(l, "AttrType")
+			default:
+				addr.Line = append(addr.Line, l.Text)
+			}
+		}
+	} else {
+		var c []Component
+		addr.Line, c = flat.streetLines()
+		carried = append(carried, c...)
+		if box := flat[ComponentPostBox]; box != "" {
+			addr.Line = append(addr.Line, "PO Box "+box)
+			carried = append(carried, ComponentPostBox)
+		}
+	}
+	return addr, f.dropped(carried...)
+}
+
+// FromFHIR converts a FHIR Address to an address.
+//
+// The use and the type set AttrUsage and AttrAddressType, eg. home to Home and billing to Bill,
+// and the period AttrValidFromDate and AttrValidToDate, keeping the date of dateTimes.
+// Addresses with a city, a district, a state or a postal code are converted to the Country branch,
+// their lines being parsed on a best-effort basis, see FromSchemaOrg, and a line such as PO Box 123 giving the post box.
+// Addresses made of lines only are converted to address lines, the country being kept in a last line of type Country,
+// and the lines of the text are used when there are no lines.
+//
+// The report lists the elements that were not converted, as JSON Pointers, eg. /text or /period/start
+// when the time of the start of the period was dropped.
+func FromFHIR(addr *FHIRAddress) (*AddressDetails, Report) {
+	if addr == nil {
+		return nil, Report{}
+	}
+	var r Report
+	country := strings.TrimSpace(addr.Country)
+	district := strings.TrimSpace(addr.District)
+	lines := nonEmpty(addr.Line)
+	var flat Flat
+	structured := addr.City != "" || district != "" || addr.State != "" || addr.PostalCode != ""
+	if structured {
+		var box string
+		lines = slices.DeleteFunc(lines, func(l string) bool {
+			m := postBoxRE.FindStringSubmatch(l)
+			if m != nil && box == "" {
+				box = m[1]
+				return true
+			}
+			return false
+		})
+		flat = parseStreetLines(lines, country)
+		flat[ComponentPostBox] = box
+		flat[ComponentLocality] = strings.TrimSpace(addr.City)
+		flat[ComponentAdminArea] = strings.TrimSpace(addr.State)
+		flat[ComponentPostalCode] = strings.TrimSpace(addr.PostalCode)
+		if countryCodeRE.MatchString(country) {
+			flat[ComponentCountryCode] = strings.ToUpper(country)
+		} else {
+			flat[ComponentCountryName] = country
+		}
+		if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && flat[ComponentCountryCode] == "US" {
+			flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+		}
+	} else {
+		flat = Flat{}
+		if len(lines) == 0 {
+			lines = nonEmpty(strings.Split(addr.Text, "\n"))
+		}
+		for i, l := range lines {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	}
+	if addr.Text != "" && (structured || len(nonEmpty(addr.Line)) > 0) {
+		r.Dropped = append(r.Dropped, "/text")
+	}
+	if use := strings.TrimSpace(addr.Use); use != "" {
+		if flat[ComponentUsage] = fhirAttrUsages[strings.ToLower(use)]; flat[ComponentUsage] == "" {
+			r.Dropped = append(r.Dropped, "/use")
+		}
+	}
+	if typ := strings.TrimSpace(addr.Type); typ != "" {
+		if flat[ComponentAddressType] = fhirAttrAddressTypes[strings.ToLower(typ)]; flat[ComponentAddressType] == "" {
+			r.Dropped = append(r.Dropped, "/type")
+		}
+	}
+	if p := addr.Period; p != nil {
+		for _, d := range []struct {
+			name, value string
+			c           Component
+		}{{"start", p.Start, ComponentValidFrom}, {"end", p.End, ComponentValidTo}} {
+			v := strings.TrimSpace(d.value)
+			flat[d.c] = dateRE.FindString(v)
+			if flat[d.c] != v {
+				r.Dropped = append(r.Dropped, Path{"period", d.name}.String())
+			}
+		}
+	}
+	a := flat.build()
+	if !structured && country != "" {
+		if a.AddressLines == nil {
+			a.AddressLines = &AddressLines{}
+		}
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: country})
+	}
+	if district != "" {
+		a.In(BranchCountry).district(district)
+	}
+	sort.Strings(r.Dropped)
+	return a, r
+}
+
+// ToFHIRAddresses converts the addresses of the list, eg. the address history of a patient,
+// to FHIR Addresses, see ToFHIR. The report lists the losses as JSON Pointers into the list.
+func ToFHIRAddresses(x *XAL) ([]*FHIRAddress, Report) {
+	if x == nil {
+		return nil, Report{}
+	}
+	var (
+		addrs []*FHIRAddress
+		r     Report
+	)
+	for i, a := range x.AddressDetails {
+		addr, ar := ToFHIR(a)
+		if addr == nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+		r = r.merge(ar, Path{"address_details", strconv.Itoa(i)})
+	}
+	return addrs, r
+}
+
+// FromFHIRAddresses converts FHIR Addresses, eg. the addresses of a Patient, to a list of addresses, see FromFHIR.
+// The report lists the losses as JSON Pointers into addrs, eg. /0/text.
+func FromFHIRAddresses(addrs []*FHIRAddress) (*XAL, Report) {
+	x := &XAL{}
+	var r Report
+	for i, addr := range addrs {
+		a, ar := FromFHIR(addr)
+		if a == nil {
+			continue
+		}
+		x.AddressDetails = append(x.AddressDetails, a)
+		r = r.merge(ar, Path{strconv.Itoa(i)})
+	}
+	return x, r
+}
@@ -0,0 +1,76 @@
+package xal
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFHIRRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		addr *FHIRAddress
+	}{
+		{"structured", &FHIRAddress{
+			Use: "home", Type: "both", Line: []string{"1 Market St", "PO Box 12"}, City: "San Francisco",
+			District: "San Francisco County", State: "CA", PostalCode: "94105-1234", Country: "US",
+			Period: &FHIRPeriod{Start: "2020-01-15", End: "2021"},
+		}},
+		{"country name", &FHIRAddress{Use: "billing", City: "Oslo", PostalCode: "0150", Country: "Norway"}},
+		{"lines", &FHIRAddress{Line: []string{"1 Market St", "San Francisco"}, Country: "US"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r := FromFHIR(tt.addr)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromFHIR dropped %v", r.Dropped)
+			}
+			got, r := ToFHIR(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToFHIR dropped %v", r.Dropped)
+			}
+			if !reflect.DeepEqual(got, tt.addr) {
+				t.Errorf("got %+v, want %+v", got, tt.addr)
+			}
+		})
+	}
+}
+
+func TestFHIRReport(t *testing.T) {
+	addr := &FHIRAddress{
+		Use: "mailing", Type: "virtual", Text: "1 Market St, San Francisco", Line: []string{"1 Market St"},
+		City: "San Francisco", Period: &FHIRPeriod{Start: "2020-01-15T09:30:00Z"},
+	}
+	a, r := FromFHIR(addr)
+	if got, want := strings.Join(r.Dropped, " "), "/period/start /text /type /use"; got != want {
+		t.Errorf("FromFHIR dropped %q, want %q", got, want)
+	}
+	if a.AttrValidFromDate != "2020-01-15" {
+		t.Errorf("got the start date %q, want 2020-01-15", a.AttrValidFromDate)
+	}
+
+	a, _ = FromFHIR(&FHIRAddress{Text: "1 Market St\nSan Francisco"})
+	if got, _ := ToFHIR(a); !reflect.DeepEqual(got.Line, []string{"1 Market St", "San Francisco"}) {
+		t.Errorf("got the lines %q of the text", got.Line)
+	}
+
+	a.AttrUsage = "Holiday"
+	if _, r := ToFHIR(a); strings.Join(r.Dropped, " ") != "/attr_usage" {
+		t.Errorf("ToFHIR dropped %v, want [/attr_usage]", r.Dropped)
+	}
+}
+
+func TestFHIRAddresses(t *testing.T) {
+	addrs := []*FHIRAddress{{City: "Oslo", Country: "NO"}, {City: "Bergen", Country: "NO", Text: "Bergen"}}
+	x, r := FromFHIRAddresses(addrs)
+	if len(x.AddressDetails) != 2 {
+		t.Fatalf("got %d addresses, want 2", len(x.AddressDetails))
+	}
+	if strings.Join(r.Dropped, " ") != "/1/text" {
+		t.Errorf("FromFHIRAddresses dropped %v, want [/1/text]", r.Dropped)
+	}
+	got, _ := ToFHIRAddresses(x)
+	if len(got) != 2 || got[1].City != "Bergen" {
+		t.Errorf("got %+v", got)
+	}
+}
@@ -0,0 +1,379 @@
+package xal
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Flat - An address flattened to a map of components, eg.
+//
+//	country_code=US admin_area=CA locality=San Francisco
+//	street_name=Market street_type=St house_number=1 unit_type=Apt unit=4 postal_code=94105
+//
+// The keys are the components listed by FlatComponents and the address line components named by AddressLineComponent.
+type Flat map[Component]string
+
+// Report - What a conversion of an address could not carry over.
+type Report struct {
+	Dropped []string // JSON Pointers to the values of the source address that were not converted, sorted
+}
+
+// merge returns the report extended with the losses of other, whose pointers are relative to base.
+func (r Report) merge(other Report, base Path) Report {
+	dropped := slices.Clone(r.Dropped)
+	for _, p := range other.Dropped {
+		dropped = append(dropped, base.String()+p)
+	}
+	sort.Strings(dropped)
+	return Report{Dropped: dropped}
+}
+
+// Flatten flattens the address to a map of components.
+//
+// Only the first occurrence of each component is kept, eg. the first LocalityName,
+// and values with no component, eg. the AttrCode of most elements, are not flattened.
+// The report lists every non-empty value that was left out.
+func (a *AddressDetails) Flatten() (Flat, Report) {
+	f := newFlattener(a)
+	return f.flat, f.report()
+}
+
+// Unflatten rebuilds an address from a Flat address, creating its nodes in BranchCountry.
+// Flatten followed by Unflatten returns an address holding the same components,
+// possibly stored in a different branch of the tree.
+//
+// Components outside of the flat vocabulary are an error.
+func Unflatten(f Flat) (*AddressDetails, error) {
+	known := map[Component]bool{}
+	for _, c := range FlatComponents {
+		known[c] = true
+	}
+	var unknown []string
+	for c := range f {
+		if _, ok := addressLineNumber(c); !ok && !known[c] {
+			unknown = append(unknown, string(c))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("xal: unknown components %s", strings.Join(unknown, ", "))
+	}
+	return f.build(), nil
+}
+
+// build rebuilds the address, ignoring unknown components.
+func (f Flat) build() *AddressDetails {
+	a := &AddressDetails{
+		AttrAddressType:   f[ComponentAddressType],
+		AttrCurrentStatus: f[ComponentCurrentStatus],
+		AttrUsage:         f[ComponentUsage],
+		AttrValidFromDate: f[ComponentValidFrom],
+		AttrValidToDate:   f[ComponentValidTo],
+	}
+	lines := map[int]string{}
+	for c, v := range f {
+		if n, ok := addressLineNumber(c); ok && v != "" {
+			lines[n] = v
+		}
+	}
+	if len(lines) > 0 {
+		numbers := make([]int, 0, len(lines))
+		for n := range lines {
+			numbers = append(numbers, n)
+		}
+		sort.Ints(numbers)
+		al := make(AddressLines, 0, len(numbers))
+		for _, n := range numbers {
+			al = append(al, &AddressLine{Text: lines[n]})
+		}
+		a.AddressLines = &al
+	}
+
+	s := a.In(BranchCountry)
+	set := func(c Component, fn func(v string)) {
+		if v := f[c]; v != "" {
+			fn(v)
+		}
+	}
+	set(ComponentCountryCode, s.SetCountryCode)
+	set(ComponentCountryName, func(v string) { s.country().CountryName = []*CountryName{{Text: v}} })
+	set(ComponentAdminArea, s.SetAdminArea)
+	set(ComponentAdminAreaType, func(v string) { s.administrativeArea().AttrType = v })
+	set(ComponentLocality, s.SetLocalityName)
+	set(ComponentLocalityType, func(v string) { s.locality().AttrType = v })
+	set(ComponentDependentLocality, func(v string) {
+		s.dependentLocality().DependentLocalityName = []*DependentLocalityName{{Text: v}}
+	})
+	set(ComponentDependentLocalityNumber, func(v string) {
+		s.dependentLocality().DependentLocalityNumber = &DependentLocalityNumber{Text: v}
+	})
+	set(ComponentStreetName, s.SetStreetName)
+	set(ComponentStreetLeadingType, func(v string) {
+		s.thoroughfare().ThoroughfareLeadingType = &ThoroughfareLeadingType{Text: v}
+	})
+	set(ComponentStreetType, func(v string) {
+		s.thoroughfare().ThoroughfareTrailingType = &ThoroughfareTrailingType{Text: v}
+	})
+	set(ComponentStreetPreDirection, func(v string) {
+		s.thoroughfare().ThoroughfarePreDirection = &ThoroughfarePreDirection{Text: v}
+	})
+	set(ComponentStreetPostDirection, func(v string) {
+		s.thoroughfare().ThoroughfarePostDirection = &ThoroughfarePostDirection{Text: v}
+	})
+	set(ComponentDependentStreetName, func(v string) {
+		s.thoroughfare().DependentThoroughfare = &DependentThoroughfare{ThoroughfareName: []*ThoroughfareName{{Text: v}}}
+	})
+	set(ComponentHouseNumber, func(v string) { s.premise().PremiseNumber = []*PremiseNumber{{Text: v}} })
+	set(ComponentHouseNumberSuffix, func(v string) { s.premise().PremiseNumberSuffix = []*PremiseNumberSuffix{{Text: v}} })
+	set(ComponentPremiseName, func(v string) { s.premise().PremiseName = []*PremiseName{{Text: v}} })
+	set(ComponentBuildingName, func(v string) { s.premise().BuildingName = []*BuildingName{{Text: v}} })
+	set(ComponentUnitType, func(v string) { s.subPremise().AttrType = v })
+	set(ComponentUnit, func(v string) { s.subPremise().SubPremiseNumber = []*SubPremiseNumber{{Text: v}} })
+	set(ComponentUnitName, func(v string) { s.subPremise().SubPremiseName = []*SubPremiseName{{Text: v}} })
+	set(ComponentPostalCode, s.SetPostalCode)
+	set(ComponentPostalCodeExtension, func(v string) {
+		s.postalCode().PostalCodeNumberExtension = []*PostalCodeNumberExtension{{Text: v}}
+	})
+	set(ComponentPostTown, func(v string) {
+		s.postalCode().PostTown = &PostTown{PostTownName: []*PostTownName{{Text: v}}}
+	})
+	set(ComponentSortingCode, func(v string) {
+		a.PostalServiceElements = &PostalServiceElements{SortingCode: &SortingCode{Text: v}}
+	})
+	postBox := func() *PostBox {
+		l := s.locality()
+		if l.PostBox == nil {
+			l.PostBox = &PostBox{}
+		}
+		return l.PostBox
+	}
+	set(ComponentPostBox, func(v string) { postBox().PostBoxNumber = &PostBoxNumber{Text: v} })
+	set(ComponentPostBoxType, func(v string) { postBox().AttrType = v })
+	set(ComponentPostOffice, func(v string) {
+		s.locality().PostOffice = &PostOffice{PostOfficeName: []*PostOfficeName{{Text: v}}}
+	})
+	set(ComponentLargeMailUser, func(v string) {
+		s.locality().LargeMailUser = &LargeMailUser{LargeMailUserName: []*LargeMailUserName{{Text: v}}}
+	})
+	return a
+}
+
+// addressLineNumber returns n for the component address_line_n.
+func addressLineNumber(c Component) (int, bool) {
+	s, ok := strings.CutPrefix(string(c), "address_line_")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n > 0
+}
+
+// fieldRef - A string field of a node.
+type fieldRef struct {
+	node  any
+	field string
+}
+
+// flattener flattens an address while recording where each component was taken from,
+// so that the values left out can be reported.
+type flattener struct {
+	flat    Flat
+	sources map[Component]string // JSON Pointer of the value each component was taken from
+	paths   map[any]Path
+	used    map[fieldRef]bool
+	root    *AddressDetails
+}
+
+func newFlattener(a *AddressDetails) *flattener {
+	f := &flattener{
+		flat:    Flat{},
+		sources: map[Component]string{},
+		paths:   map[any]Path{},
+		used:    map[fieldRef]bool{},
+		root:    a,
+	}
+	if a == nil {
+		return f
+	}
+	Walk(a, func(path Path, node any) error {
+		f.paths[node] = path
+		return nil
+	})
+
+	f.take(ComponentAddressType, a, "AttrAddressType")
+	f.take(ComponentCurrentStatus, a, "AttrCurrentStatus")
+	f.take(ComponentUsage, a, "AttrUsage")
+	f.take(ComponentValidFrom, a, "AttrValidFromDate")
+	f.take(ComponentValidTo, a, "AttrValidToDate")
+	if a.AddressLines != nil {
+		for i, l := range *a.AddressLines {
+			f.take(AddressLineComponent(i+1), l, "Text")
+		}
+	}
+	if c := a.Country; c != nil {
+		f.take(ComponentCountryCode, first(c.CountryNameCode), "Text")
+		f.take(ComponentCountryName, first(c.CountryName), "Text")
+	}
+	if aa := a.administrativeArea(); aa != nil {
+		f.take(ComponentAdminArea, first(aa.AdministrativeAreaName), "Text")
+		f.take(ComponentAdminAreaType, aa, "AttrType")
+	}
+	l := a.locality()
+	if l != nil {
+		f.take(ComponentLocality, first(l.LocalityName), "Text")
+		f.take(ComponentLocalityType, l, "AttrType")
+		if l.LargeMailUser != nil {
+			f.take(ComponentLargeMailUser, first(l.LargeMailUser.LargeMailUserName), "Text")
+		}
+	}
+	if dl := a.dependentLocality(); dl != nil {
+		f.take(ComponentDependentLocality, first(dl.DependentLocalityName), "Text")
+		f.take(ComponentDependentLocalityNumber, dl.DependentLocalityNumber, "Text")
+	}
+	t := a.thoroughfare()
+	if t != nil {
+		f.take(ComponentStreetName, first(t.ThoroughfareName), "Text")
+		f.take(ComponentStreetLeadingType, t.ThoroughfareLeadingType, "Text")
+		f.take(ComponentStreetType, t.ThoroughfareTrailingType, "Text")
+		f.take(ComponentStreetPreDirection, t.ThoroughfarePreDirection, "Text")
+		f.take(ComponentStreetPostDirection, t.ThoroughfarePostDirection, "Text")
+		if t.DependentThoroughfare != nil {
+			f.take(ComponentDependentStreetName, first(t.DependentThoroughfare.ThoroughfareName), "Text")
+		}
+	}
+	if p := a.premise(); p != nil {
+		f.take(ComponentHouseNumber, first(p.PremiseNumber), "Text")
+		f.take(ComponentHouseNumberSuffix, first(p.PremiseNumberSuffix), "Text")
+		f.take(ComponentPremiseName, first(p.PremiseName), "Text")
+		f.take(ComponentBuildingName, first(p.BuildingName), "Text")
+	}
+	if t != nil && f.flat[ComponentHouseNumber] == "" {
+		f.take(ComponentHouseNumber, first(t.ThoroughfareNumber), "Text")
+		f.take(ComponentHouseNumberSuffix, first(t.ThoroughfareNumberSuffix), "Text")
+	}
+	if sp := a.subPremise(); sp != nil {
+		f.take(ComponentUnitType, sp, "AttrType")
+		f.take(ComponentUnit, first(sp.SubPremiseNumber), "Text")
+		f.take(ComponentUnitName, first(sp.SubPremiseName), "Text")
+	}
+	if pc := a.postalCode(); pc != nil {
+		f.take(ComponentPostalCode, first(pc.PostalCodeNumber), "Text")
+		f.take(ComponentPostalCodeExtension, first(pc.PostalCodeNumberExtension), "Text")
+		if pc.PostTown != nil {
+			f.take(ComponentPostTown, first(pc.PostTown.PostTownName), "Text")
+		}
+	}
+	if pse := a.PostalServiceElements; pse != nil {
+		f.take(ComponentSortingCode, pse.SortingCode, "Text")
+	}
+	if pb := a.postBox(); pb != nil {
+		f.take(ComponentPostBox, pb.PostBoxNumber, "Text")
+		f.take(ComponentPostBoxType, pb, "AttrType")
+	}
+	if po := a.postOffice(); po != nil {
+		f.take(ComponentPostOffice, first(po.PostOfficeName), "Text")
+	}
+	return f
+}
+
+// take sets component c to the value of field of node, unless the value is empty or c is already set.
+func (f *flattener) take(c Component, node any, field string) {
+	rv := reflect.ValueOf(node)
+	if !rv.IsValid() || rv.IsNil() || f.flat[c] != "" {
+		return
+	}
+	v := rv.Elem().FieldByName(field)
+	if v.String() == "" {
+		return
+	}
+	f.flat[c] = v.String()
+	f.used[fieldRef{node, field}] = true
+	f.sources[c] = f.pointer(node, field)
+}
+
+// first returns the first element of a repeated element, or nil.
+func first[T any](s []*T) *T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s[0]
+}
+
+// pointer returns the JSON Pointer to field of node.
+func (f *flattener) pointer(node any, field string) string {
+	sf, _ := reflect.TypeOf(node).Elem().FieldByName(field)
+	return f.paths[node].child(jsonName(sf)).String()
+}
+
+// report lists the non-empty values of the address that no component was taken from.
+func (f *flattener) report() Report {
+	var r Report
+	if f.root == nil {
+		return r
+	}
+	Walk(f.root, func(path Path, node any) error {
+		v := reflect.ValueOf(node).Elem()
+		if v.Kind() != reflect.Struct {
+			return nil
+		}
+		for i := 0; i < v.NumField(); i++ {
+			sf := v.Type().Field(i)
+			if sf.Type.Kind() == reflect.String && v.Field(i).String() != "" && !f.used[fieldRef{node, sf.Name}] {
+				r.Dropped = append(r.Dropped, path.child(jsonName(sf)).String())
+			}
+		}
+		return nil
+	})
+	sort.Strings(r.Dropped)
+	return r
+}
+
+// dropped returns the report of the flattening, extended with the sources of the components
+// of the flat address that a conversion did not carry over.
+func (f *flattener) dropped(carried ...Component) Report {
+	r := f.report()
+	keep := map[Component]bool{}
+	for _, c := range carried {
+		keep[c] = true
+	}
+	for c, src := range f.sources {
+		if !keep[c] {
+			r.Dropped = append(r.Dropped, src)
+		}
+	}
+	sort.Strings(r.Dropped)
+	return r
+}
+
+// use marks field of node as converted, for the values a conversion carries without a component.
+func (f *flattener) use(node any, field string) {
+	f.used[fieldRef{node, field}] = true
+}
+
+// adminAreaNames returns the first name of the administrative area but those of type District,
+// and the first name of type District, eg. a county, marking them as converted.
+func (f *flattener) adminAreaNames() (name, district string) {
+	aa := f.root.administrativeArea()
+	if aa == nil {
+		return "", ""
+	}
+	for _, n := range aa.AdministrativeAreaName {
+		switch {
+		case n == nil || n.Text == "":
+			continue
+		case strings.EqualFold(n.AttrType, "District") && district == "":
+			district = n.Text
+			f.use(n, "AttrType")
+		case !strings.EqualFold(n.AttrType, "District") && name == "":
+			name = n.Text
+		default:
+			continue
+		}
+		f.use(n, "Text")
+	}
+	return name, district
+}
@@ -0,0 +1,451 @@
+//go:build ignore
+
+// gen_model.go reads the xAL and xNL types declared in xal.go and xnl.go and generates model_gen.go,
+// which holds the code that has to know about every type of the model:
+// the tree walker, the typed visitor interfaces and the checks of the
+// maxLength, enum and choice constraints found in the field comments.
+//
+// It also generates xal.schema.json, the JSON Schema (draft 2020-12) of the JSON encoding of the model,
+// which carries the same constraints.
+//
+// Run it with go generate whenever a type is added to or changed in xal.go or xnl.go.
+// Run it with -check to verify that the generated files are up to date, eg. in CI:
+//
+//	go run gen_model.go -check
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// kind is the shape of a type declared in xal.go or xnl.go.
+type kind int
+
+const (
+	kindStruct kind = iota // eg. Country
+	kindSlice              // eg. AddressLines, a named slice of another model type
+)
+
+type modelType struct {
+	name   string
+	doc    string // doc comment, without the leading "Name - "
+	kind   kind
+	elem   string // element type of a kindSlice
+	fields []modelField
+}
+
+type modelField struct {
+	name      string
+	json      string
+	typ       string   // model type the field holds, or "" for plain strings
+	slice     bool     // the field holds a slice of typ
+	maxLength int      // from a maxLength=N comment, 0 if unconstrained
+	enum      []string // from an enum=A|B comment, the values allowed
+	choice    bool     // from a choice comment
+	doc       string   // the comment without the constraints
+}
+
+var maxLengthRE = regexp.MustCompile(`\bmaxLength=(\d+)`)
+var choiceRE = regexp.MustCompile(`(^|\W)choice(\W|$)`)
+var enumRE = regexp.MustCompile(`\benum=([^\s;]+)`)
+
+// constraintRE matches a constraint of a field comment, with its separator.
+var constraintRE = regexp.MustCompile(`^\s*(maxLength=\d+|enum=[^\s;]+|choice)\s*(;|$)`)
+
+func main() {
+	check := flag.Bool("check", false, "report the generated files that are out of date instead of writing them")
+	flag.Parse()
+	var types []*modelType
+	for _, file := range []string{"xal.go", "xnl.go"} {
+		t, err := parseModel(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		types = append(types, t...)
+	}
+	resolveFields(types)
+	src, err := format.Source(generate(types))
+	if err != nil {
+		log.Fatal(err)
+	}
+	schema, err := generateSchema(types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	files := []struct {
+		name string
+		src  []byte
+	}{{"model_gen.go", src}, {"xal.schema.json", schema}}
+	stale := false
+	for _, f := range files {
+		if *check {
+			if old, err := os.ReadFile(f.name); err != nil || !bytes.Equal(old, f.src) {
+				fmt.Fprintf(os.Stderr, "%s is out of date, run go generate\n", f.name)
+				stale = true
+			}
+			continue
+		}
+		if err := os.WriteFile(f.name, f.src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if stale {
+		os.Exit(1)
+	}
+}
+
+// parseModel returns the types declared in file, in declaration order.
+func parseModel(file string) ([]*modelType, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	var types []*modelType
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			t := &modelType{name: ts.Name.Name}
+			if ts.Doc != nil {
+				t.doc = strings.TrimPrefix(strings.TrimSpace(ts.Doc.Text()), t.name+" - ")
+			}
+			switch typ := ts.Type.(type) {
+			case *ast.StructType:
+				t.kind = kindStruct
+				for _, f := range typ.Fields.List {
+					mf, err := parseField(f)
+					if err != nil {
+						return nil, fmt.Errorf("%s: %v", t.name, err)
+					}
+					t.fields = append(t.fields, mf)
+				}
+			case *ast.ArrayType:
+				t.kind = kindSlice
+				t.elem = typeName(typ.Elt)
+			default:
+				return nil, fmt.Errorf("%s: unsupported type %T", t.name, ts.Type)
+			}
+			types = append(types, t)
+		}
+	}
+	return types, nil
+}
+
+// resolveFields clears the type of the fields of a plain type such as string, which are not model types.
+func resolveFields(types []*modelType) {
+	known := map[string]bool{}
+	for _, t := range types {
+		known[t.name] = true
+	}
+	for _, t := range types {
+		for i := range t.fields {
+			if !known[t.fields[i].typ] {
+				t.fields[i].typ = ""
+			}
+		}
+	}
+}
+
+func parseField(f *ast.Field) (modelField, error) {
+	if len(f.Names) != 1 {
+		return modelField{}, fmt.Errorf("embedded or grouped fields are not supported")
+	}
+	mf := modelField{name: f.Names[0].Name, json: f.Names[0].Name}
+	if f.Tag != nil {
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			return mf, err
+		}
+		if name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ","); name != "" {
+			mf.json = name
+		}
+	}
+	if f.Comment != nil {
+		text := f.Comment.Text()
+		if m := maxLengthRE.FindStringSubmatch(text); m != nil {
+			mf.maxLength, _ = strconv.Atoi(m[1])
+		}
+		if m := enumRE.FindStringSubmatch(text); m != nil {
+			mf.enum = strings.Split(m[1], "|")
+		}
+		mf.choice = choiceRE.MatchString(text)
+		mf.doc = strings.TrimSpace(text)
+		for m := constraintRE.FindString(mf.doc); m != ""; m = constraintRE.FindString(mf.doc) {
+			mf.doc = strings.TrimSpace(mf.doc[len(m):])
+		}
+	}
+	if at, ok := f.Type.(*ast.ArrayType); ok {
+		mf.slice = true
+		mf.typ = typeName(at.Elt)
+	} else {
+		mf.typ = typeName(f.Type)
+	}
+	return mf, nil
+}
+
+// typeName returns the name of the type expr refers to, through a pointer.
+func typeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func generate(types []*modelType) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_model.go; DO NOT EDIT.\n\npackage xal\n\nimport \"strconv\"\n\n")
+
+	// Visitor interfaces
+	b.WriteString("type (\n")
+	for _, t := range types {
+		fmt.Fprintf(&b, "// %[1]sVisitor - Implemented by visitors passed to Visit that handle *%[1]s nodes.\n", t.name)
+		fmt.Fprintf(&b, "%[1]sVisitor interface {\n Visit%[1]s(path Path, node *%[1]s) error\n}\n\n", t.name)
+	}
+	b.WriteString(")\n\n")
+
+	// isNode
+	b.WriteString("// isNode reports whether node is a pointer to one of the xAL or xNL types.\n")
+	b.WriteString("func isNode(node any) bool {\nswitch node.(type) {\ncase ")
+	for i, t := range types {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "*%s", t.name)
+	}
+	b.WriteString(":\nreturn true\n}\nreturn false\n}\n\n")
+
+	// visit
+	b.WriteString("// visit calls the method of the typed visitor interface v implements for node, if any.\n")
+	b.WriteString("func visit(v any, path Path, node any) error {\nswitch n := node.(type) {\n")
+	for _, t := range types {
+		fmt.Fprintf(&b, "case *%[1]s:\nif v, ok := v.(%[1]sVisitor); ok {\nreturn v.Visit%[1]s(path, n)\n}\n", t.name)
+	}
+	b.WriteString("}\nreturn nil\n}\n\n")
+
+	// walkChildren
+	b.WriteString("// walkChildren walks the non-nil children of node, in field order.\n")
+	b.WriteString("func walkChildren(path Path, node any, fn WalkFunc) error {\nswitch n := node.(type) {\n")
+	for _, t := range types {
+		switch t.kind {
+		case kindSlice:
+			fmt.Fprintf(&b, "case *%s:\nfor i, c := range *n {\nif c == nil {\ncontinue\n}\n", t.name)
+			b.WriteString("if err := walk(path.child(strconv.Itoa(i)), c, fn); err != nil {\nreturn err\n}\n}\n")
+		case kindStruct:
+			var body bytes.Buffer
+			for _, f := range t.fields {
+				switch {
+				case f.typ == "":
+				case f.slice:
+					fmt.Fprintf(&body, "for i, c := range n.%s {\nif c == nil {\ncontinue\n}\n", f.name)
+					fmt.Fprintf(&body, "if err := walk(path.child(%q, strconv.Itoa(i)), c, fn); err != nil {\nreturn err\n}\n}\n", f.json)
+				default:
+					fmt.Fprintf(&body, "if n.%s != nil {\n", f.name)
+					fmt.Fprintf(&body, "if err := walk(path.child(%q), n.%s, fn); err != nil {\nreturn err\n}\n}\n", f.json, f.name)
+				}
+			}
+			if body.Len() > 0 {
+				fmt.Fprintf(&b, "case *%s:\n", t.name)
+				b.Write(body.Bytes())
+			}
+		}
+	}
+	b.WriteString("}\nreturn nil\n}\n\n")
+
+	// validateNode
+	b.WriteString("// validateNode checks the maxLength and choice constraints of the fields of node.\n")
+	b.WriteString("func validateNode(path Path, node any) (errs ValidationErrors) {\nswitch n := node.(type) {\n")
+	for _, t := range types {
+		var body bytes.Buffer
+		var choices []modelField
+		for _, f := range t.fields {
+			if f.maxLength > 0 && f.typ == "" && !f.slice {
+				fmt.Fprintf(&body, "errs = errs.checkLength(path, %q, n.%s, %d)\n", f.json, f.name, f.maxLength)
+			}
+			if len(f.enum) > 0 && f.typ == "" && !f.slice {
+				fmt.Fprintf(&body, "errs = errs.checkEnum(path, %q, n.%s", f.json, f.name)
+				for _, v := range f.enum {
+					fmt.Fprintf(&body, ", %q", v)
+				}
+				body.WriteString(")\n")
+			}
+			if f.choice {
+				choices = append(choices, f)
+			}
+		}
+		if len(choices) > 0 {
+			body.WriteString("errs = errs.checkChoice(path, []string{")
+			for i, f := range choices {
+				if i > 0 {
+					body.WriteString(", ")
+				}
+				fmt.Fprintf(&body, "%q", f.json)
+			}
+			body.WriteString("}, ")
+			for i, f := range choices {
+				if i > 0 {
+					body.WriteString(", ")
+				}
+				fmt.Fprintf(&body, "n.%s != nil", f.name)
+			}
+			body.WriteString(")\n")
+		}
+		if body.Len() > 0 {
+			fmt.Fprintf(&b, "case *%s:\n", t.name)
+			b.Write(body.Bytes())
+		}
+	}
+	b.WriteString("}\nreturn errs\n}\n")
+	return b.Bytes()
+}
+
+// object is a JSON object whose members are written in order.
+type object []member
+
+type member struct {
+	key   string
+	value any
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("{")
+	for i, m := range o {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		key, err := marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteString(":")
+		b.Write(value)
+	}
+	b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+// marshal encodes v without escaping the HTML characters of the comments, eg. & in 12 & 14.
+func marshal(v any) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// generateSchema returns the JSON Schema of the JSON encoding of the types, the first being the root.
+// Every type is a definition of $defs, eg. #/$defs/AddressDetails to validate a single address.
+func generateSchema(types []*modelType) ([]byte, error) {
+	defs := object{}
+	for _, t := range types {
+		def := object{}
+		if t.doc != "" {
+			def = append(def, member{"description", t.doc})
+		}
+		switch t.kind {
+		case kindSlice:
+			def = append(def, member{"type", "array"}, member{"items", ref(t.elem)})
+		case kindStruct:
+			properties := object{}
+			var choices []string
+			for _, f := range t.fields {
+				properties = append(properties, member{f.json, fieldSchema(f)})
+				if f.choice {
+					choices = append(choices, f.json)
+				}
+			}
+			def = append(def, member{"type", "object"}, member{"properties", properties}, member{"additionalProperties", false})
+			if len(choices) > 1 {
+				def = append(def, member{"not", choiceSchema(choices)})
+			}
+		}
+		defs = append(defs, member{t.name, def})
+	}
+	schema := object{
+		{"$schema", "https://json-schema.org/draft/2020-12/schema"},
+		{"title", "xAL"},
+		{"$comment", "Code generated by gen_model.go; DO NOT EDIT."},
+		{"description", "JSON encoding of the OASIS xAL 2.0 and xNL 2.0 types."},
+		{"$ref", "#/$defs/" + types[0].name},
+		{"$defs", defs},
+	}
+	src, err := marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := json.Indent(&b, src, "", "  "); err != nil {
+		return nil, err
+	}
+	b.WriteString("\n")
+	return b.Bytes(), nil
+}
+
+// ref returns the schema of a value of the model type.
+func ref(typ string) object {
+	return object{{"$ref", "#/$defs/" + typ}}
+}
+
+// fieldSchema returns the schema of the value of the field, with its constraints.
+func fieldSchema(f modelField) object {
+	s := object{}
+	if f.typ != "" {
+		s = ref(f.typ)
+	} else {
+		s = append(s, member{"type", "string"})
+		if f.maxLength > 0 {
+			s = append(s, member{"maxLength", f.maxLength})
+		}
+		if len(f.enum) > 0 {
+			s = append(s, member{"enum", f.enum})
+		}
+	}
+	if f.slice {
+		s = object{{"type", "array"}, {"items", s}}
+	}
+	if f.doc != "" {
+		s = append(object{{"description", f.doc}}, s...)
+	}
+	return s
+}
+
+// choiceSchema returns the schema of the objects holding more than one of the fields of a choice,
+// which the objects must not match.
+func choiceSchema(fields []string) object {
+	var pairs []object
+	for i, a := range fields {
+		for _, b := range fields[i+1:] {
+			pairs = append(pairs, object{{"required", []string{a, b}}})
+		}
+	}
+	if len(pairs) == 1 {
+		return pairs[0]
+	}
+	return object{{"anyOf", pairs}}
+}
@@ -0,0 +1,48 @@
+package xal
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedUpToDate fails when model_gen.go or xal.schema.json is out of date with xal.go and xnl.go,
+// see gen_model.go.
+func TestGeneratedUpToDate(t *testing.T) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	out, err := exec.Command(gobin, "run", "gen_model.go", "-check").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run gen_model.go -check: %v\n%s\nrun go generate to update the generated files", err, out)
+	}
+}
+
+// TestTypesUpToDate fails when xal.go or xnl.go is out of date with the schemas, see cmd/xalgen.
+func TestTypesUpToDate(t *testing.T) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	dir := t.TempDir()
+	out, err := exec.Command(gobin, "run", "./cmd/xalgen", "-d", dir, "-limits", "schema/limits.txt", "schema/xAL.xsd", "schema/xNL.xsd").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run ./cmd/xalgen: %v\n%s", err, out)
+	}
+	for _, name := range []string{"xal.go", "xnl.go"} {
+		want, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date with the schemas, run go generate to update it", name)
+		}
+	}
+}
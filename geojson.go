@@ -0,0 +1,290 @@
+package xal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Errors of the GeoJSON conversions.
+var (
+	ErrInvalidCoordinate = errors.New("xal: invalid coordinate")
+	ErrInvalidGeoJSON    = errors.New("xal: invalid GeoJSON")
+)
+
+// GeoJSONFeature - An RFC 7946 Feature locating an address, with the flattened address as its properties, eg.
+//
+//	{"type": "Feature", "geometry": {"type": "Point", "coordinates": [-122.3959, 37.7937]}, "properties": {"locality": "San Francisco"}}
+type GeoJSONFeature struct {
+	Type       string           `json:"type"`       // Feature
+	Geometry   *GeoJSONGeometry `json:"geometry"`   // null for addresses without coordinates
+	Properties Flat             `json:"properties"` // See Flatten
+}
+
+// GeoJSONGeometry - A GeoJSON geometry. Addresses are located by a Point.
+//
+// Decoding keeps the type of the other geometries, eg. Polygon, but not their coordinates.
+type GeoJSONGeometry struct {
+	Type        string    `json:"type"`        // Point
+	Coordinates []float64 `json:"coordinates"` // Longitude then latitude, in decimal degrees
+}
+
+func (g *GeoJSONGeometry) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*g = GeoJSONGeometry{Type: v.Type}
+	if v.Type != "Point" || len(v.Coordinates) == 0 {
+		return nil
+	}
+	return json.Unmarshal(v.Coordinates, &g.Coordinates)
+}
+
+// GeoJSONFeatureCollection - An RFC 7946 FeatureCollection of addresses.
+type GeoJSONFeatureCollection struct {
+	Type     string            `json:"type"` // FeatureCollection
+	Features []*GeoJSONFeature `json:"features"`
+}
+
+var (
+	// degreesRE matches a coordinate in decimal degrees, or in degrees, minutes and seconds with any separators,
+	// eg. -37.7937, 37°47'37.3"N, 37 47 37.3 or N 37:47.62, capturing the sign, the numbers and the direction letters.
+	degreesRE = regexp.MustCompile(`^([NSEW]?)\s*(-?)\s*(\d+(?:\.\d+)?)\s*[°º]?(?:[:\s]?\s*(\d+(?:\.\d+)?)\s*['′]?(?:[:\s]?\s*(\d+(?:\.\d+)?)\s*["″]?)?)?\s*([NSEW]?)$`)
+	// directionNames maps the direction words of AddressLatitudeDirection and AddressLongitudeDirection to letters.
+	directionNames = map[string]string{"NORTH": "N", "SOUTH": "S", "EAST": "E", "WEST": "W"}
+)
+
+// ParseDegrees parses a latitude or a longitude of an address to signed decimal degrees, eg. 37°47'37.3" with
+// the direction S to -37.793694. The text can be in decimal degrees, or in degrees, minutes and seconds, and
+// can hold its own direction letter, eg. 122°23'45"W. The direction is N, S, E or W, or the word, eg. South,
+// and is checked against the axis and the sign: latitude is true for a latitude, false for a longitude.
+//
+// The error wraps ErrInvalidCoordinate, eg. for minutes of 60 or more, or a latitude beyond 90 degrees.
+func ParseDegrees(text, direction string, latitude bool) (float64, error) {
+	t := strings.ToUpper(strings.TrimSpace(text))
+	m := degreesRE.FindStringSubmatch(t)
+	if m == nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidCoordinate, text)
+	}
+	dirs := nonEmpty([]string{m[1], m[6]})
+	if d := strings.ToUpper(strings.TrimSpace(direction)); d != "" {
+		if name, ok := directionNames[d]; ok {
+			d = name
+		}
+		dirs = append(dirs, d)
+	}
+	positive, negative := "E", "W"
+	limit := 180.0
+	if latitude {
+		positive, negative, limit = "N", "S", 90
+	}
+	v, _ := strconv.ParseFloat(m[3], 64)
+	for i, unit := range []float64{60, 3600} {
+		if s := m[4+i]; s != "" {
+			if strings.Contains(m[3+i], ".") {
+				return 0, fmt.Errorf("%w: %q has decimals before its last part", ErrInvalidCoordinate, text)
+			}
+			part, _ := strconv.ParseFloat(s, 64)
+			if part >= 60 {
+				return 0, fmt.Errorf("%w: %q has %s minutes or seconds", ErrInvalidCoordinate, text, s)
+			}
+			v += part / unit
+		}
+	}
+	negate, pos := m[2] == "-", false
+	for _, d := range dirs {
+		switch d {
+		case positive:
+			pos = true
+		case negative:
+			negate = true
+		default:
+			return 0, fmt.Errorf("%w: %q is not a direction of a %s", ErrInvalidCoordinate, d, axisName(latitude))
+		}
+	}
+	if negate && pos {
+		return 0, fmt.Errorf("%w: %q is both %s and %s", ErrInvalidCoordinate, text, positive, negative)
+	}
+	if v > limit {
+		return 0, fmt.Errorf("%w: %q is beyond %g degrees", ErrInvalidCoordinate, text, limit)
+	}
+	if negate {
+		v = -v
+	}
+	return v, nil
+}
+
+// axisName names the axis of a coordinate parsed by ParseDegrees.
+func axisName(latitude bool) string {
+	if latitude {
+		return "latitude"
+	}
+	return "longitude"
+}
+
+// ToGeoJSON converts the address to a GeoJSON Feature, whose properties are the flattened address, see Flatten.
+//
+// The Point of the feature is the AddressLatitude and the AddressLongitude of the postal service elements,
+// with their directions, see ParseDegrees. Addresses without both have no geometry.
+// The error wraps ErrInvalidCoordinate for coordinates that cannot be parsed.
+//
+// The report lists what Flatten leaves out, eg. the AttrCode of most elements.
+func ToGeoJSON(a *AddressDetails) (*GeoJSONFeature, Report, error) {
+	if a == nil {
+		return nil, Report{}, nil
+	}
+	f := newFlattener(a)
+	feature := &GeoJSONFeature{Type: "Feature", Properties: f.flat}
+	if p := a.PostalServiceElements; p != nil && p.AddressLatitude != nil && p.AddressLongitude != nil {
+		var latDir, longDir string
+		if p.AddressLatitudeDirection != nil {
+			latDir = p.AddressLatitudeDirection.Text
+			f.use(p.AddressLatitudeDirection, "Text")
+		}
+		if p.AddressLongitudeDirection != nil {
+			longDir = p.AddressLongitudeDirection.Text
+			f.use(p.AddressLongitudeDirection, "Text")
+		}
+		lat, err := ParseDegrees(p.AddressLatitude.Text, latDir, true)
+		if err != nil {
+			return nil, Report{}, err
+		}
+		long, err := ParseDegrees(p.AddressLongitude.Text, longDir, false)
+		if err != nil {
+			return nil, Report{}, err
+		}
+		f.use(p.AddressLatitude, "Text")
+		f.use(p.AddressLongitude, "Text")
+		feature.Geometry = &GeoJSONGeometry{Type: "Point", Coordinates: []float64{long, lat}}
+	}
+	carried := make([]Component, 0, len(f.flat))
+	for c := range f.flat {
+		carried = append(carried, c)
+	}
+	return feature, f.dropped(carried...), nil
+}
+
+// FromGeoJSON converts a GeoJSON Feature to an address, see ToGeoJSON.
+//
+// The properties are unflattened, see Unflatten, and the Point gives the latitude and the longitude
+// in decimal degrees, without sign, and their directions, eg. 37.7937 N and 122.3959 W.
+// The error wraps ErrInvalidGeoJSON for a feature that is not a Feature, or a Point out of range.
+//
+// The report lists the values that were not converted, as JSON Pointers, eg. /properties/name for a property
+// outside of the flat vocabulary, or /geometry for a geometry other than a Point.
+func FromGeoJSON(feature *GeoJSONFeature) (*AddressDetails, Report, error) {
+	if feature == nil {
+		return nil, Report{}, nil
+	}
+	if feature.Type != "Feature" {
+		return nil, Report{}, fmt.Errorf("%w: type %q is not Feature", ErrInvalidGeoJSON, feature.Type)
+	}
+	var r Report
+	known := map[Component]bool{}
+	for _, c := range FlatComponents {
+		known[c] = true
+	}
+	flat := Flat{}
+	for c, v := range feature.Properties {
+		if _, ok := addressLineNumber(c); !ok && !known[c] {
+			r.Dropped = append(r.Dropped, Path{"properties", string(c)}.String())
+			continue
+		}
+		if v = strings.TrimSpace(v); v != "" {
+			flat[c] = v
+		}
+	}
+	a := flat.build()
+	switch g := feature.Geometry; {
+	case g == nil:
+	case g.Type != "Point" || len(g.Coordinates) < 2:
+		r.Dropped = append(r.Dropped, "/geometry")
+	default:
+		long, lat := g.Coordinates[0], g.Coordinates[1]
+		if lat < -90 || lat > 90 || long < -180 || long > 180 {
+			return nil, Report{}, fmt.Errorf("%w: point %g, %g is out of range", ErrInvalidGeoJSON, long, lat)
+		}
+		if len(g.Coordinates) > 2 {
+			r.Dropped = append(r.Dropped, "/geometry/coordinates/2")
+		}
+		if a.PostalServiceElements == nil {
+			a.PostalServiceElements = &PostalServiceElements{}
+		}
+		p := a.PostalServiceElements
+		p.AddressLatitude = &AddressLatitude{Text: formatDegrees(lat)}
+		p.AddressLatitudeDirection = &AddressLatitudeDirection{Text: "N"}
+		if lat < 0 {
+			p.AddressLatitudeDirection.Text = "S"
+		}
+		p.AddressLongitude = &AddressLongitude{Text: formatDegrees(long)}
+		p.AddressLongitudeDirection = &AddressLongitudeDirection{Text: "E"}
+		if long < 0 {
+			p.AddressLongitudeDirection.Text = "W"
+		}
+	}
+	sort.Strings(r.Dropped)
+	return a, r, nil
+}
+
+// formatDegrees formats v in decimal degrees without its sign, eg. 122.3959.
+func formatDegrees(v float64) string {
+	if v < 0 {
+		v = -v
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// ToGeoJSONCollection converts every address of the list to a feature of a FeatureCollection, see ToGeoJSON.
+// The report lists the losses as JSON Pointers into x, eg. /address_details/0/postal_service_elements/barcode/text.
+func ToGeoJSONCollection(x *XAL) (*GeoJSONFeatureCollection, Report, error) {
+	if x == nil {
+		return nil, Report{}, nil
+	}
+	c := &GeoJSONFeatureCollection{Type: "FeatureCollection", Features: []*GeoJSONFeature{}}
+	var r Report
+	for i, a := range x.AddressDetails {
+		feature, fr, err := ToGeoJSON(a)
+		if err != nil {
+			return nil, Report{}, fmt.Errorf("%w (address %d)", err, i)
+		}
+		if feature == nil {
+			continue
+		}
+		c.Features = append(c.Features, feature)
+		r = r.merge(fr, Path{"address_details", strconv.Itoa(i)})
+	}
+	return c, r, nil
+}
+
+// FromGeoJSONCollection converts the features of a FeatureCollection to a list of addresses, see FromGeoJSON.
+// The report lists the losses as JSON Pointers into c, eg. /features/0/properties/name.
+func FromGeoJSONCollection(c *GeoJSONFeatureCollection) (*XAL, Report, error) {
+	if c == nil {
+		return nil, Report{}, nil
+	}
+	if c.Type != "FeatureCollection" {
+		return nil, Report{}, fmt.Errorf("%w: type %q is not FeatureCollection", ErrInvalidGeoJSON, c.Type)
+	}
+	x := &XAL{}
+	var r Report
+	for i, feature := range c.Features {
+		a, fr, err := FromGeoJSON(feature)
+		if err != nil {
+			return nil, Report{}, fmt.Errorf("%w (feature %d)", err, i)
+		}
+		if a == nil {
+			continue
+		}
+		x.AddressDetails = append(x.AddressDetails, a)
+		r = r.merge(fr, Path{"features", strconv.Itoa(i)})
+	}
+	return x, r, nil
+}
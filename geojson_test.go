@@ -0,0 +1,113 @@
+package xal
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseDegrees(t *testing.T) {
+	tests := []struct {
+		text, direction string
+		latitude        bool
+		want            float64
+		err             bool
+	}{
+		{"37.7937", "", true, 37.7937, false},
+		{"-37.7937", "", true, -37.7937, false},
+		{"37.7937", "S", true, -37.7937, false},
+		{"37.7937", "south", true, -37.7937, false},
+		{`37°47'37.3"N`, "", true, 37 + 47.0/60 + 37.3/3600, false},
+		{`37°47′37.3″ S`, "", true, -(37 + 47.0/60 + 37.3/3600), false},
+		{"37 47 37.3", "", true, 37 + 47.0/60 + 37.3/3600, false},
+		{"N 37:47.62", "", true, 37 + 47.62/60, false},
+		{`122°23'45"W`, "", false, -(122 + 23.0/60 + 45.0/3600), false},
+		{"122.3959", "West", false, -122.3959, false},
+		{"180", "E", false, 180, false},
+		{"90", "", true, 90, false},
+		{"90.5", "", true, 0, true},
+		{"181", "", false, 0, true},
+		{"37 60", "", true, 0, true},
+		{"37 47 60", "", true, 0, true},
+		{"37.5 47", "", true, 0, true},
+		{"37", "E", true, 0, true},
+		{"122", "N", false, 0, true},
+		{"37 N", "S", true, 0, true},
+		{"-37", "N", true, 0, true},
+		{"-37", "S", true, -37, false},
+		{"north", "", true, 0, true},
+		{"", "", true, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text+" "+tt.direction, func(t *testing.T) {
+			got, err := ParseDegrees(tt.text, tt.direction, tt.latitude)
+			if tt.err {
+				if !errors.Is(err, ErrInvalidCoordinate) {
+					t.Fatalf("ParseDegrees() = %v, %v, want an error wrapping ErrInvalidCoordinate", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("ParseDegrees() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeoJSONRoundTrip(t *testing.T) {
+	const doc = `{"type":"Feature","geometry":{"type":"Point","coordinates":[-122.3959,37.7937]},` +
+		`"properties":{"country_code":"US","house_number":"1","locality":"San Francisco","postal_code":"94105","street_name":"Market"}}`
+	var feature GeoJSONFeature
+	if err := json.Unmarshal([]byte(doc), &feature); err != nil {
+		t.Fatal(err)
+	}
+	a, r, err := FromGeoJSON(&feature)
+	if err != nil || len(r.Dropped) > 0 {
+		t.Fatalf("FromGeoJSON() dropped %v, error %v", r.Dropped, err)
+	}
+	if p := a.PostalServiceElements; p.AddressLatitude.Text != "37.7937" || p.AddressLongitude.Text != "122.3959" ||
+		p.AddressLatitudeDirection.Text != "N" || p.AddressLongitudeDirection.Text != "W" {
+		t.Errorf("FromGeoJSON() coordinates %+v", p)
+	}
+	got, r, err := ToGeoJSON(a)
+	if err != nil || len(r.Dropped) > 0 {
+		t.Fatalf("ToGeoJSON() dropped %v, error %v", r.Dropped, err)
+	}
+	if b, _ := json.Marshal(got); string(b) != doc {
+		t.Errorf("got %s\nwant %s", b, doc)
+	}
+}
+
+func TestFromGeoJSONReport(t *testing.T) {
+	tests := []struct {
+		name, doc string
+		want      string
+		err       error
+	}{
+		{"unknown property", `{"type":"Feature","properties":{"locality":"Oslo","name":"Opera"}}`, "/properties/name", nil},
+		{"not a point", `{"type":"Feature","geometry":{"type":"LineString","coordinates":[[1,2],[3,4]]},"properties":{}}`, "/geometry", nil},
+		{"altitude", `{"type":"Feature","geometry":{"type":"Point","coordinates":[10.75,59.91,12]},"properties":{}}`, "/geometry/coordinates/2", nil},
+		{"out of range", `{"type":"Feature","geometry":{"type":"Point","coordinates":[10.75,91]},"properties":{}}`, "", ErrInvalidGeoJSON},
+		{"not a feature", `{"type":"Point","properties":{}}`, "", ErrInvalidGeoJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var feature GeoJSONFeature
+			if err := json.Unmarshal([]byte(tt.doc), &feature); err != nil {
+				t.Fatal(err)
+			}
+			_, r, err := FromGeoJSON(&feature)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("FromGeoJSON() error = %v, want %v", err, tt.err)
+			}
+			if got := strings.Join(r.Dropped, " "); got != tt.want {
+				t.Errorf("FromGeoJSON() dropped %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package xal
+
+import (
+	"sort"
+	"strings"
+)
+
+// GooglePostalAddress - The google.type.PostalAddress message of the Google common protos,
+// with the JSON names of its fields.
+type GooglePostalAddress struct {
+	Revision           int32    `json:"revision,omitempty"`
+	RegionCode         string   `json:"regionCode"`                   // CLDR region code, eg. US
+	LanguageCode       string   `json:"languageCode,omitempty"`       // BCP-47 language code, eg. en-US
+	PostalCode         string   `json:"postalCode,omitempty"`         // eg. 94105
+	SortingCode        string   `json:"sortingCode,omitempty"`        // eg. CEDEX 16
+	AdministrativeArea string   `json:"administrativeArea,omitempty"` // eg. CA
+	Locality           string   `json:"locality,omitempty"`           // eg. San Francisco
+	Sublocality        string   `json:"sublocality,omitempty"`        // eg. a district
+	AddressLines       []string `json:"addressLines,omitempty"`       // Lines below the locality, eg. 1 Market St
+	Recipients         []string `json:"recipients,omitempty"`
+	Organization       string   `json:"organization,omitempty"`
+}
+
+// ToGoogle converts the names of the recipients and the address to a google.type.PostalAddress.
+//
+// The first organisation name becomes the organization, and the other names the recipients,
+// a recipient per line, see NameDetails.Lines.
+// The premise, street, house number and unit become the address lines, see ToSchemaOrg, and the
+// dependent locality the sublocality. Addresses made of address lines keep their lines, but for
+// a line whose AttrType is Country which becomes the region code.
+// The report lists what the message cannot carry, eg. post boxes and the attributes of the address.
+func ToGoogle(names []*NameDetails, a *AddressDetails) (*GooglePostalAddress, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	g := &GooglePostalAddress{
+		RegionCode:         flat[ComponentCountryCode],
+		PostalCode:         joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		SortingCode:        flat[ComponentSortingCode],
+		AdministrativeArea: flat[ComponentAdminArea],
+		Locality:           flat[ComponentLocality],
+		Sublocality:        flat[ComponentDependentLocality],
+	}
+	carried := []Component{
+		ComponentCountryCode, ComponentPostalCode, ComponentPostalCodeExtension, ComponentSortingCode,
+		ComponentAdminArea, ComponentLocality, ComponentDependentLocality,
+	}
+	if a.AddressLines != nil {
+		for i, l := range *a.AddressLines {
+			carried = append(carried, AddressLineComponent(i+1))
+			switch {
+			case l == nil || l.Text == "":
+			case strings.EqualFold(l.AttrType, "Country") && g.RegionCode == "":
+				g.RegionCode = l.Text
+				f.use(l, "AttrType")
+			default:
+				g.AddressLines = append(g.AddressLines, l.Text)
+			}
+		}
+	} else {
+		var c []Component
+		g.AddressLines, c = flat.streetLines()
+		carried = append(carried, c...)
+	}
+	for _, n := range names {
+		lines := n.Lines()
+		if n != nil && n.OrganisationNameDetails != nil && g.Organization == "" && len(lines) > 0 {
+			g.Organization, lines = lines[0], lines[1:]
+		}
+		g.Recipients = append(g.Recipients, lines...)
+	}
+	return g, f.dropped(carried...)
+}
+
+// FromGoogle converts a google.type.PostalAddress to the names of its recipients and an address.
+//
+// Messages with an administrative area, a locality, a sublocality or a postal code are converted
+// to the Country branch, their address lines being parsed on a best-effort basis, see FromSchemaOrg.
+// Messages made of address lines only are converted to address lines, the region code
+// being kept in a last line of type Country, so that ToGoogle returns the same lines.
+// The sorting code goes to the PostalServiceElements.
+// Each recipient gives a name made of a name line, see NameFromLines, and the organization
+// a last name of party type Organisation, with its OrganisationNameDetails.
+// The report lists the fields that were not converted, as JSON Pointers, eg. /languageCode.
+func FromGoogle(g *GooglePostalAddress) ([]*NameDetails, *AddressDetails, Report) {
+	if g == nil {
+		return nil, nil, Report{}
+	}
+	var r Report
+	if g.LanguageCode != "" {
+		r.Dropped = append(r.Dropped, "/languageCode")
+	}
+	if g.Revision != 0 {
+		r.Dropped = append(r.Dropped, "/revision")
+	}
+	region := strings.ToUpper(strings.TrimSpace(g.RegionCode))
+	var flat Flat
+	structured := g.AdministrativeArea != "" || g.Locality != "" || g.Sublocality != "" || g.PostalCode != ""
+	if structured {
+		flat = parseStreetLines(g.AddressLines, region)
+		flat[ComponentCountryCode] = region
+		flat[ComponentAdminArea] = strings.TrimSpace(g.AdministrativeArea)
+		flat[ComponentLocality] = strings.TrimSpace(g.Locality)
+		flat[ComponentDependentLocality] = strings.TrimSpace(g.Sublocality)
+		flat[ComponentPostalCode] = strings.TrimSpace(g.PostalCode)
+		if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && region == "US" {
+			flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+		}
+	} else {
+		flat = Flat{}
+		for i, l := range nonEmpty(g.AddressLines) {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	}
+	flat[ComponentSortingCode] = strings.TrimSpace(g.SortingCode)
+	a := flat.build()
+	if !structured && region != "" {
+		if a.AddressLines == nil {
+			a.AddressLines = &AddressLines{}
+		}
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: region})
+	}
+	var names []*NameDetails
+	for _, recipient := range nonEmpty(g.Recipients) {
+		names = append(names, NameFromLines(recipient))
+	}
+	if org := strings.TrimSpace(g.Organization); org != "" {
+		names = append(names, &NameDetails{
+			AttrPartyType:           "Organisation",
+			OrganisationNameDetails: &OrganisationNameDetails{OrganisationName: []*OrganisationName{{Text: org}}},
+		})
+	}
+	sort.Strings(r.Dropped)
+	return names, a, r
+}
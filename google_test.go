@@ -0,0 +1,65 @@
+package xal
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGoogleRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *GooglePostalAddress
+	}{
+		{"structured", &GooglePostalAddress{
+			RegionCode: "US", PostalCode: "94105-1234", AdministrativeArea: "CA", Locality: "San Francisco",
+			AddressLines: []string{"1 Market St"}, Recipients: []string{"Jane Doe"}, Organization: "Acme Corp",
+		}},
+		{"sorting code", &GooglePostalAddress{
+			RegionCode: "FR", PostalCode: "75116", SortingCode: "CEDEX 16", Locality: "Paris", Sublocality: "Passy",
+			AddressLines: []string{"12 Rue de la Paix"},
+		}},
+		{"lines", &GooglePostalAddress{RegionCode: "US", AddressLines: []string{"1 Market St", "San Francisco"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, a, r := FromGoogle(tt.g)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromGoogle dropped %v", r.Dropped)
+			}
+			got, r := ToGoogle(names, a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToGoogle dropped %v", r.Dropped)
+			}
+			if !reflect.DeepEqual(got, tt.g) {
+				t.Errorf("got %+v, want %+v", got, tt.g)
+			}
+		})
+	}
+}
+
+func TestGoogleReport(t *testing.T) {
+	g := &GooglePostalAddress{Revision: 1, RegionCode: "CH", LanguageCode: "de-CH", Locality: "Zürich"}
+	_, a, r := FromGoogle(g)
+	if got, want := strings.Join(r.Dropped, " "), "/languageCode /revision"; got != want {
+		t.Errorf("FromGoogle dropped %q, want %q", got, want)
+	}
+	if a.LocalityName() != "Zürich" {
+		t.Errorf("got the locality %q", a.LocalityName())
+	}
+
+	a = &AddressDetails{Country: &Country{
+		CountryNameCode: []*CountryNameCode{{Text: "NO"}},
+		Locality: &Locality{
+			LocalityName: []*LocalityName{{Text: "Oslo"}},
+			PostBox:      &PostBox{PostBoxNumber: &PostBoxNumber{Text: "12"}},
+		},
+	}}
+	got, r := ToGoogle(nil, a)
+	if got.Locality != "Oslo" || len(got.AddressLines) > 0 {
+		t.Errorf("got %+v", got)
+	}
+	if len(r.Dropped) != 1 {
+		t.Errorf("ToGoogle dropped %v, want the post box", r.Dropped)
+	}
+}
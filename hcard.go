@@ -0,0 +1,180 @@
+package xal
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// hcardProperties are the class names of the properties of an hCard adr, without their p- prefix.
+var hcardProperties = map[string]bool{
+	"type": true, "post-office-box": true, "extended-address": true, "street-address": true,
+	"locality": true, "region": true, "postal-code": true, "country-name": true, "label": true,
+}
+
+// HCard encodes the address as an hCard adr, with both the microformats 1 and 2 class names, eg.
+//
+//	<div class="adr h-adr"><span class="type">work</span><div class="street-address p-street-address">1 Market St</div>...</div>
+//
+// The lines of the street are separated by <br>. The label is not encoded.
+func (v *VCardAddress) HCard() string {
+	var b strings.Builder
+	b.WriteString(`<div class="adr h-adr">`)
+	for _, t := range v.Types {
+		fmt.Fprintf(&b, `<span class="type">%s</span>`, html.EscapeString(t))
+	}
+	prop := func(tag, class, value string) {
+		if value == "" {
+			return
+		}
+		lines := strings.Split(value, "\n")
+		for i, l := range lines {
+			lines[i] = html.EscapeString(l)
+		}
+		fmt.Fprintf(&b, `<%[1]s class="%[2]s p-%[2]s">%[3]s</%[1]s>`, tag, class, strings.Join(lines, "<br>"))
+	}
+	prop("div", "post-office-box", v.PostOfficeBox)
+	prop("div", "extended-address", v.Extended)
+	prop("div", "street-address", v.Street)
+	prop("span", "locality", v.Locality)
+	prop("span", "region", v.Region)
+	prop("span", "postal-code", v.PostalCode)
+	prop("div", "country-name", v.Country)
+	b.WriteString(`</div>`)
+	return b.String()
+}
+
+// ParseHCard returns the hCard adr found in an HTML document, with the class names
+// of either microformats 1, eg. street-address, or 2, eg. p-street-address.
+//
+// Repeated properties are joined as in ParseVCardADR. The HTML does not need to be well-formed.
+func ParseHCard(r io.Reader) ([]*VCardAddress, error) {
+	in := &endReader{r: r}
+	d := xml.NewDecoder(in)
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	type frame struct {
+		adr  bool   // the element is an adr
+		prop string // the element is a property of the adr
+	}
+	var (
+		addrs []*VCardAddress
+		cur   *VCardAddress
+		stack []frame
+		prop  string
+		text  strings.Builder
+	)
+	for {
+		tok, err := d.Token()
+		var syntaxErr *xml.SyntaxError
+		if err == io.EOF || errors.As(err, &syntaxErr) && in.end && d.InputOffset() == in.n {
+			// Elements left open, eg. <html>, are closed by the end of the document:
+			// the decoder fails once it has read the whole document.
+			if cur != nil {
+				if prop != "" {
+					cur.setHCard(prop, text.String())
+				}
+				addrs = append(addrs, cur)
+			}
+			return addrs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("xal: invalid hCard: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			var f frame
+			var classes []string
+			for _, a := range t.Attr {
+				if a.Name.Local == "class" {
+					classes = strings.Fields(a.Value)
+				}
+			}
+			for _, c := range classes {
+				switch name := strings.TrimPrefix(c, "p-"); {
+				case cur == nil && (c == "adr" || c == "h-adr"):
+					cur, f.adr = &VCardAddress{}, true
+				case cur != nil && prop == "" && hcardProperties[name]:
+					f.prop, prop = name, name
+					text.Reset()
+				}
+			}
+			if prop != "" && strings.EqualFold(t.Name.Local, "br") {
+				text.WriteByte('\n')
+			}
+			stack = append(stack, f)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				continue
+			}
+			f := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if f.prop != "" {
+				cur.setHCard(f.prop, text.String())
+				prop = ""
+			}
+			if f.adr {
+				addrs = append(addrs, cur)
+				cur = nil
+			}
+		case xml.CharData:
+			if prop != "" {
+				text.Write(t)
+			}
+		}
+	}
+}
+
+// endReader records whether r reached the end of its input, and the number of bytes read until then.
+type endReader struct {
+	r   io.Reader
+	n   int64
+	end bool
+}
+
+func (e *endReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	e.n += int64(n)
+	e.end = e.end || err == io.EOF
+	return n, err
+}
+
+// setHCard sets the property of the adr named by class to the text of its element,
+// with the white space of each line collapsed.
+func (v *VCardAddress) setHCard(class, text string) {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.Join(strings.Fields(l), " ")
+	}
+	lines = nonEmpty(lines)
+	add := func(field *string, sep string) {
+		*field = joinNonEmpty([]string{*field, strings.Join(lines, sep)}, sep)
+	}
+	switch class {
+	case "type":
+		if len(lines) > 0 {
+			v.Types = append(v.Types, strings.ToLower(strings.Join(lines, " ")))
+		}
+	case "post-office-box":
+		add(&v.PostOfficeBox, ", ")
+	case "extended-address":
+		add(&v.Extended, ", ")
+	case "street-address":
+		add(&v.Street, "\n")
+	case "locality":
+		add(&v.Locality, ", ")
+	case "region":
+		add(&v.Region, ", ")
+	case "postal-code":
+		add(&v.PostalCode, ", ")
+	case "country-name":
+		add(&v.Country, ", ")
+	case "label":
+		add(&v.Label, "\n")
+	}
+}
@@ -0,0 +1,39 @@
+package xal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseHCard(t *testing.T) {
+	tests := []struct {
+		name    string
+		html    string
+		want    []VCardAddress
+		wantErr bool
+	}{
+		{"closed", `<div class="adr"><span class="locality">Oslo</span></div>`, []VCardAddress{{Locality: "Oslo"}}, false},
+		{"microformats 2", `<p class="h-adr"><span class="p-street-address">1 Main St<br>Apt 4</span></p>`,
+			[]VCardAddress{{Street: "1 Main St\nApt 4"}}, false},
+		{"elements left open", `<html><body><div class="adr"><span class="locality">Oslo`, []VCardAddress{{Locality: "Oslo"}}, false},
+		{"tag left open", `<div class="adr"><span class="locality">Oslo</span><span`, []VCardAddress{{Locality: "Oslo"}}, false},
+		{"no adr", `<p>Oslo</p>`, nil, false},
+		{"invalid", `<div class="adr"><span class="locality">Oslo</span><!-- - -- --></div>`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHCard(strings.NewReader(tt.html))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseHCard() error = %v, want error %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseHCard() = %d addresses, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i].String() {
+					t.Errorf("address %d = %s, want %s", i, got[i], &tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package xal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidXAD is returned when an HL7 v2 XAD field cannot be parsed.
+var ErrInvalidXAD = errors.New("xal: invalid HL7 v2 XAD field")
+
+// XADAddress - An HL7 v2 XAD extended address, eg. the PID-11 patient address, as defined by HL7 v2.5:
+//
+//	1 Market St^Apt 4^San Francisco^CA^94105^USA^H^^^^^^20200115
+//
+// The components hold the values without their escape sequences. The street of XAD.1 is followed by
+// its street name and dwelling number subcomponents. XAD.12, the deprecated validity range, is read
+// into the effective and expiration dates when these are empty, and never written.
+type XADAddress struct {
+	Street             string `json:"street,omitempty"`             // XAD.1.1 Street or mailing address, eg. 1 Market St
+	StreetName         string `json:"streetName,omitempty"`         // XAD.1.2
+	DwellingNumber     string `json:"dwellingNumber,omitempty"`     // XAD.1.3
+	OtherDesignation   string `json:"otherDesignation,omitempty"`   // XAD.2, eg. Apt 4
+	City               string `json:"city,omitempty"`               // XAD.3
+	State              string `json:"state,omitempty"`              // XAD.4
+	PostalCode         string `json:"postalCode,omitempty"`         // XAD.5
+	Country            string `json:"country,omitempty"`            // XAD.6, ISO 3166 code, eg. USA
+	AddressType        string `json:"addressType,omitempty"`        // XAD.7, HL7 table 0190, eg. H for home
+	OtherGeographic    string `json:"otherGeographic,omitempty"`    // XAD.8, eg. a borough
+	County             string `json:"county,omitempty"`             // XAD.9
+	CensusTract        string `json:"censusTract,omitempty"`        // XAD.10
+	RepresentationCode string `json:"representationCode,omitempty"` // XAD.11, HL7 table 0465
+	EffectiveDate      string `json:"effectiveDate,omitempty"`      // XAD.13, DTM, eg. 20200115
+	ExpirationDate     string `json:"expirationDate,omitempty"`     // XAD.14, DTM
+}
+
+var (
+	// hl7Escaper and hl7Unescaper handle the escape sequences of the default delimiters |^~\&.
+	hl7Escaper   = strings.NewReplacer(`\`, `\E\`, "|", `\F\`, "^", `\S\`, "~", `\R\`, "&", `\T\`)
+	hl7Unescaper = strings.NewReplacer(`\F\`, "|", `\S\`, "^", `\R\`, "~", `\E\`, `\`, `\T\`, "&")
+	// dtmRE matches the date at the start of an HL7 v2 DTM, eg. 2020, 202001 or 20200115.
+	dtmRE = regexp.MustCompile(`^(\d{4})(\d{2})?(\d{2})?`)
+)
+
+// xadUsages maps the HL7 table 0190 codes of XAD.7 to AttrUsage, and xadAddressTypes the other codes to AttrAddressType.
+var (
+	xadUsages       = map[string]string{"H": "Home", "B": "Work", "O": "Office", "C": "Temp", "BI": "Bill"}
+	xadAddressTypes = map[string]string{
+		"BA": "BadAddress", "BDL": "BirthDeliveryLocation", "BR": "BirthResidence", "F": "CountryOfOrigin",
+		"L": "Legal", "M": "Postal", "N": "Birth", "P": "Permanent", "RH": "RegistryHome", "SH": "Shipping", "V": "Vacation",
+	}
+)
+
+// ParseXAD parses an XAD field with the default delimiters, eg. 1 Market St^^San Francisco^CA^94105^USA^H.
+// The repetitions of the field, separated by ~, are returned in order, eg. the address history of a patient.
+func ParseXAD(field string) ([]*XADAddress, error) {
+	field = strings.TrimRight(field, "\r\n")
+	if strings.Contains(field, "|") {
+		return nil, fmt.Errorf("%w: field separator in %q", ErrInvalidXAD, field)
+	}
+	var addrs []*XADAddress
+	for _, rep := range strings.Split(field, "~") {
+		if rep == "" {
+			continue
+		}
+		comps := strings.Split(rep, "^")
+		if len(comps) > 14 {
+			return nil, fmt.Errorf("%w: %d components, the maximum is 14", ErrInvalidXAD, len(comps))
+		}
+		for _, c := range comps {
+			if strings.Count(c, `\`)%2 != 0 {
+				return nil, fmt.Errorf("%w: unterminated escape sequence in %q", ErrInvalidXAD, c)
+			}
+		}
+		comps = append(comps, make([]string, 14-len(comps))...)
+		value := func(s string) string {
+			return strings.TrimSpace(hl7Unescaper.Replace(s))
+		}
+		sub := strings.Split(comps[0], "&")
+		sub = append(sub, "", "")
+		validity := strings.Split(comps[11], "&")
+		validity = append(validity, "")
+		x := &XADAddress{
+			Street:             value(sub[0]),
+			StreetName:         value(sub[1]),
+			DwellingNumber:     value(sub[2]),
+			OtherDesignation:   value(comps[1]),
+			City:               value(comps[2]),
+			State:              value(comps[3]),
+			PostalCode:         value(comps[4]),
+			Country:            value(comps[5]),
+			AddressType:        value(comps[6]),
+			OtherGeographic:    value(comps[7]),
+			County:             value(comps[8]),
+			CensusTract:        value(comps[9]),
+			RepresentationCode: value(comps[10]),
+			EffectiveDate:      value(comps[12]),
+			ExpirationDate:     value(comps[13]),
+		}
+		if x.EffectiveDate == "" && x.ExpirationDate == "" {
+			x.EffectiveDate, x.ExpirationDate = value(validity[0]), value(validity[1])
+		}
+		addrs = append(addrs, x)
+	}
+	return addrs, nil
+}
+
+// String encodes the address as an XAD field with the default delimiters, without its trailing empty components.
+func (x *XADAddress) String() string {
+	esc := hl7Escaper.Replace
+	street := strings.TrimRight(strings.Join([]string{esc(x.Street), esc(x.StreetName), esc(x.DwellingNumber)}, "&"), "&")
+	comps := []string{
+		street, esc(x.OtherDesignation), esc(x.City), esc(x.State), esc(x.PostalCode), esc(x.Country),
+		esc(x.AddressType), esc(x.OtherGeographic), esc(x.County), esc(x.CensusTract), esc(x.RepresentationCode),
+		"", esc(x.EffectiveDate), esc(x.ExpirationDate),
+	}
+	return strings.TrimRight(strings.Join(comps, "^"), "^")
+}
+
+// FormatXAD encodes the addresses as the repetitions of an XAD field, see XADAddress.String.
+func FormatXAD(addrs []*XADAddress) string {
+	reps := make([]string, 0, len(addrs))
+	for _, x := range addrs {
+		if x != nil {
+			reps = append(reps, x.String())
+		}
+	}
+	return strings.Join(reps, "~")
+}
+
+// ToXAD converts the address to an XAD.
+//
+// The street line goes to the street, with the street name and the house number in its subcomponents,
+// and the premise name, the building name and the unit to the other designation, eg. Apt 4.
+// The post box goes to the street of addresses without a street, and to the other designation otherwise.
+// The dependent locality goes to the other geographic designation, and the administrative area name
+// of type District to the county. AttrUsage or else AttrAddressType is mapped to the address type, eg. Home to H,
+// and AttrValidFromDate and AttrValidToDate, dates such as 2020-01-15, to the effective and expiration dates.
+// Addresses made of address lines keep their first line in the street and the others in the other designation,
+// but for a line of type Country which becomes the country.
+//
+// The report lists what the XAD cannot carry, eg. the name of the country and the types without an HL7 code.
+func ToXAD(a *AddressDetails) (*XADAddress, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	x := &XADAddress{
+		City:            flat[ComponentLocality],
+		PostalCode:      joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		Country:         flat[ComponentCountryCode],
+		OtherGeographic: flat[ComponentDependentLocality],
+	}
+	carried := []Component{
+		ComponentLocality, ComponentAdminArea, ComponentPostalCode, ComponentPostalCodeExtension,
+		ComponentCountryCode, ComponentDependentLocality,
+	}
+	x.State, x.County = f.adminAreaNames()
+	for code, usage := range xadUsages {
+		if strings.EqualFold(flat[ComponentUsage], usage) {
+			x.AddressType = code
+			carried = append(carried, ComponentUsage)
+		}
+	}
+	if x.AddressType == "" {
+		for code, typ := range xadAddressTypes {
+			if strings.EqualFold(flat[ComponentAddressType], typ) {
+				x.AddressType = code
+				carried = append(carried, ComponentAddressType)
+			}
+		}
+	}
+	for _, d := range []struct {
+		date *string
+		c    Component
+	}{{&x.EffectiveDate, ComponentValidFrom}, {&x.ExpirationDate, ComponentValidTo}} {
+		if v := flat[d.c]; dateRE.FindString(v) == v && v != "" {
+			*d.date = strings.ReplaceAll(v, "-", "")
+			carried = append(carried, d.c)
+		}
+	}
+
+	if a.AddressLines != nil {
+		var lines []string
+		for i, l := range *a.AddressLines {
+			carried = append(carried, AddressLineComponent(i+1))
+			switch {
+			case l == nil || l.Text == "":
+			case strings.EqualFold(l.AttrType, "Country") && x.Country == "":
+				x.Country = strings.ToUpper(l.Text)
+				f.use(l, "AttrType")
+			default:
+				lines = append(lines, l.Text)
+			}
+		}
+		if len(lines) > 0 {
+			x.Street, x.OtherDesignation = lines[0], strings.Join(lines[1:], ", ")
+		}
+		return x, f.dropped(carried...)
+	}
+
+	street := maps.Clone(flat)
+	for _, c := range append(unitComponents, ComponentPremiseName, ComponentBuildingName) {
+		delete(street, c)
+	}
+	lines, c := street.streetLines()
+	carried = append(carried, c...)
+	x.Street = strings.Join(lines, ", ")
+	if len(lines) > 0 {
+		x.StreetName = joinNonEmpty([]string{
+			flat[ComponentStreetPreDirection], flat[ComponentStreetLeadingType], flat[ComponentStreetName],
+			flat[ComponentStreetType], flat[ComponentStreetPostDirection],
+		}, " ")
+		x.DwellingNumber = flat[ComponentHouseNumber] + flat[ComponentHouseNumberSuffix]
+	}
+	other := []string{
+		flat[ComponentPremiseName], flat[ComponentBuildingName],
+		joinNonEmpty([]string{flat[ComponentUnitType], flat[ComponentUnit], flat[ComponentUnitName]}, " "),
+	}
+	carried = append(carried, ComponentPremiseName, ComponentBuildingName)
+	carried = append(carried, unitComponents...)
+	if box := flat[ComponentPostBox]; box != "" {
+		carried = append(carried, ComponentPostBox)
+		if x.Street == "" {
+			x.Street = "PO Box " + box
+		} else {
+			other = append(other, "PO Box "+box)
+		}
+	}
+	x.OtherDesignation = joinNonEmpty(other, ", ")
+	return x, f.dropped(carried...)
+}
+
+// FromXAD converts an XAD to an address.
+//
+// The street and the other designation are parsed on a best-effort basis, see FromSchemaOrg, unless the street
+// name or the dwelling number are set, and a part such as PO Box 123 gives the post box. The other geographic
+// designation becomes the dependent locality and the county an administrative area name of type District.
+// The address type sets AttrUsage, eg. H to Home, or AttrAddressType, eg. M to Postal, and the effective and
+// expiration dates AttrValidFromDate and AttrValidToDate, as YYYY-MM-DD.
+// XADs with a street and an other designation only are converted to address lines, the country being
+// kept in a last line of type Country.
+//
+// The report lists the components that were not converted, as JSON Pointers, eg. /censusTract
+// or /effectiveDate when the time of the date was dropped.
+func FromXAD(x *XADAddress) (*AddressDetails, Report) {
+	if x == nil {
+		return nil, Report{}
+	}
+	var r Report
+	country := strings.ToUpper(strings.TrimSpace(x.Country))
+	var parts []string
+	for _, p := range append([]string{x.Street}, strings.Split(x.OtherDesignation, ",")...) {
+		parts = append(parts, strings.TrimSpace(p))
+	}
+	parts = nonEmpty(parts)
+	county := strings.TrimSpace(x.County)
+	var flat Flat
+	structured := x.City != "" || x.State != "" || x.PostalCode != "" || county != "" || x.OtherGeographic != "" ||
+		x.StreetName != "" || x.DwellingNumber != ""
+	if structured {
+		var box string
+		var lines []string
+		for _, p := range parts {
+			if m := postBoxRE.FindStringSubmatch(p); m != nil && box == "" {
+				box = m[1]
+				continue
+			}
+			lines = append(lines, p)
+		}
+		if name, number := strings.TrimSpace(x.StreetName), strings.TrimSpace(x.DwellingNumber); name != "" || number != "" {
+			if len(lines) > 0 && lines[0] == strings.TrimSpace(x.Street) {
+				lines = lines[1:]
+			}
+			flat = parseStreetLines(lines, country)
+			flat[ComponentStreetName], flat[ComponentHouseNumber] = name, number
+		} else {
+			flat = parseStreetLines(lines, country)
+		}
+		flat[ComponentPostBox] = box
+		flat[ComponentLocality] = strings.TrimSpace(x.City)
+		flat[ComponentAdminArea] = strings.TrimSpace(x.State)
+		flat[ComponentPostalCode] = strings.TrimSpace(x.PostalCode)
+		flat[ComponentDependentLocality] = strings.TrimSpace(x.OtherGeographic)
+		flat[ComponentCountryCode] = country
+		if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && (country == "US" || country == "USA") {
+			flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+		}
+	} else {
+		flat = Flat{}
+		for i, p := range parts {
+			flat[AddressLineComponent(i+1)] = p
+		}
+	}
+	if t := strings.ToUpper(strings.TrimSpace(x.AddressType)); t != "" {
+		switch {
+		case xadUsages[t] != "":
+			flat[ComponentUsage] = xadUsages[t]
+		case xadAddressTypes[t] != "":
+			flat[ComponentAddressType] = xadAddressTypes[t]
+		default:
+			r.Dropped = append(r.Dropped, "/addressType")
+		}
+	}
+	for _, d := range []struct {
+		name, value string
+		c           Component
+	}{{"effectiveDate", x.EffectiveDate, ComponentValidFrom}, {"expirationDate", x.ExpirationDate, ComponentValidTo}} {
+		v := strings.TrimSpace(d.value)
+		m := dtmRE.FindStringSubmatch(v)
+		if m != nil {
+			flat[d.c] = joinNonEmpty(m[1:], "-")
+		}
+		if v != "" && (m == nil || m[0] != v) {
+			r.Dropped = append(r.Dropped, Path{d.name}.String())
+		}
+	}
+	if x.CensusTract != "" {
+		r.Dropped = append(r.Dropped, "/censusTract")
+	}
+	if x.RepresentationCode != "" {
+		r.Dropped = append(r.Dropped, "/representationCode")
+	}
+	a := flat.build()
+	if !structured && country != "" {
+		if a.AddressLines == nil {
+			a.AddressLines = &AddressLines{}
+		}
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: country})
+	}
+	if county != "" {
+		a.In(BranchCountry).district(county)
+	}
+	sort.Strings(r.Dropped)
+	return a, r
+}
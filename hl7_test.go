@@ -0,0 +1,98 @@
+package xal
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestXADString(t *testing.T) {
+	tests := []struct {
+		name  string
+		x     *XADAddress
+		field string
+	}{
+		{"address", &XADAddress{
+			Street: "1 Market St", OtherDesignation: "Apt 4", City: "San Francisco", State: "CA", PostalCode: "94105",
+			Country: "USA", AddressType: "H", EffectiveDate: "20200115",
+		}, "1 Market St^Apt 4^San Francisco^CA^94105^USA^H^^^^^^20200115"},
+		{"street subcomponents", &XADAddress{Street: "1 Market St", StreetName: "Market St", DwellingNumber: "1"}, "1 Market St&Market St&1"},
+		{"escapes", &XADAddress{Street: `Smith & Sons^1|2~3\4`}, `Smith \T\ Sons\S\1\F\2\R\3\E\4`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.field {
+				t.Errorf("String() = %s, want %s", got, tt.field)
+			}
+			addrs, err := ParseXAD(tt.field)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(addrs) != 1 || !reflect.DeepEqual(addrs[0], tt.x) {
+				t.Errorf("ParseXAD(%s) = %+v, want %+v", tt.field, addrs, tt.x)
+			}
+		})
+	}
+}
+
+func TestParseXAD(t *testing.T) {
+	addrs, err := ParseXAD("1 Market St^^San Francisco~^^Oslo^^^^^^^^^20200115&20211231\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 2 || addrs[0].City != "San Francisco" || addrs[1].City != "Oslo" {
+		t.Fatalf("got %+v", addrs)
+	}
+	if addrs[1].EffectiveDate != "20200115" || addrs[1].ExpirationDate != "20211231" {
+		t.Errorf("got the dates %q and %q of the validity range", addrs[1].EffectiveDate, addrs[1].ExpirationDate)
+	}
+	if got := FormatXAD(addrs); got != "1 Market St^^San Francisco~^^Oslo^^^^^^^^^^20200115^20211231" {
+		t.Errorf("FormatXAD() = %s", got)
+	}
+
+	for _, field := range []string{"1 Market St|PID", `1 Market St\T`, strings.Repeat("^", 14)} {
+		if _, err := ParseXAD(field); err == nil {
+			t.Errorf("ParseXAD(%q) succeeded", field)
+		}
+	}
+}
+
+func TestXADRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *XADAddress
+	}{
+		{"structured", &XADAddress{
+			Street: "1 Market St", StreetName: "Market St", DwellingNumber: "1", OtherDesignation: "Apt 4",
+			City: "San Francisco", State: "CA", PostalCode: "94105-1234", Country: "USA", AddressType: "M", County: "San Francisco County", EffectiveDate: "20200115",
+		}},
+		{"post box", &XADAddress{Street: "PO Box 12", City: "Oslo", PostalCode: "0150", Country: "NOR", AddressType: "H"}},
+		{"lines", &XADAddress{Street: "1 Market St", OtherDesignation: "San Francisco", Country: "USA"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r := FromXAD(tt.x)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromXAD dropped %v", r.Dropped)
+			}
+			got, r := ToXAD(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToXAD dropped %v", r.Dropped)
+			}
+			if got.String() != tt.x.String() {
+				t.Errorf("got %s, want %s", got, tt.x)
+			}
+		})
+	}
+}
+
+func TestXADReport(t *testing.T) {
+	x := &XADAddress{City: "Oslo", AddressType: "ZZ", CensusTract: "1", RepresentationCode: "A", EffectiveDate: "202001151230"}
+	a, r := FromXAD(x)
+	if got, want := strings.Join(r.Dropped, " "), "/addressType /censusTract /effectiveDate /representationCode"; got != want {
+		t.Errorf("FromXAD dropped %q, want %q", got, want)
+	}
+	if a.AttrValidFromDate != "2020-01-15" {
+		t.Errorf("got the effective date %q, want 2020-01-15", a.AttrValidFromDate)
+	}
+}
@@ -0,0 +1,223 @@
+package xal
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ISO19160Address - An address in the conceptual model of ISO 19160-1, as held by address registers:
+// an ordered list of components, the object it addresses and its lifecycle, eg.
+//
+//	{"lifecycleStage": "current", "components": [{"type": "addressNumber", "value": "1"}, {"type": "thoroughfareName", "value": "Market St"}]}
+type ISO19160Address struct {
+	ID              string                     `json:"id,omitempty"`              // Identifier of the address in its register
+	Class           string                     `json:"class,omitempty"`           // Address class, eg. Postal
+	LifecycleStage  string                     `json:"lifecycleStage,omitempty"`  // proposed | reserved | current | retired
+	ValidFrom       string                     `json:"validFrom,omitempty"`       // Start of the lifespan, eg. 2020-01-15
+	ValidTo         string                     `json:"validTo,omitempty"`         // End of the lifespan
+	Components      []*ISO19160Component       `json:"components"`                // In the order of the flat vocabulary, see FlatComponents
+	AddressedObject *ISO19160AddressableObject `json:"addressedObject,omitempty"` // The object the address gives access to
+}
+
+// ISO19160Component - An address component: a typed value, eg. thoroughfareName Market St.
+type ISO19160Component struct {
+	Type  string `json:"type"` // One of the ISO19160 component types, eg. addressNumber
+	Value string `json:"value"`
+}
+
+// ISO19160AddressableObject - The object an address gives access to, eg. a building.
+type ISO19160AddressableObject struct {
+	Type string `json:"type"`         // building | subaddress | postBox
+	ID   string `json:"id,omitempty"` // Identifier of the object in its register
+}
+
+// ISO 19160-1 lifecycle stages of an address.
+const (
+	ISO19160Proposed = "proposed"
+	ISO19160Reserved = "reserved"
+	ISO19160Current  = "current"
+	ISO19160Retired  = "retired"
+)
+
+// iso19160ComponentTypes maps the components of the flat vocabulary to ISO 19160-1 component types.
+// The components of the address itself, eg. usage, are not listed.
+var iso19160ComponentTypes = map[Component]string{
+	ComponentCountryCode:             "countryCode",
+	ComponentCountryName:             "countryName",
+	ComponentAdminArea:               "administrativeAreaName",
+	ComponentAdminAreaType:           "administrativeAreaType",
+	ComponentLocality:                "localityName",
+	ComponentLocalityType:            "localityType",
+	ComponentDependentLocality:       "subLocalityName",
+	ComponentDependentLocalityNumber: "subLocalityNumber",
+	ComponentStreetName:              "thoroughfareName",
+	ComponentStreetLeadingType:       "thoroughfareLeadingType",
+	ComponentStreetType:              "thoroughfareTrailingType",
+	ComponentStreetPreDirection:      "thoroughfarePreDirection",
+	ComponentStreetPostDirection:     "thoroughfarePostDirection",
+	ComponentDependentStreetName:     "dependentThoroughfareName",
+	ComponentHouseNumber:             "addressNumber",
+	ComponentHouseNumberSuffix:       "addressNumberSuffix",
+	ComponentPremiseName:             "addressableObjectName",
+	ComponentBuildingName:            "buildingName",
+	ComponentUnitType:                "subaddressType",
+	ComponentUnit:                    "subaddressIdentifier",
+	ComponentUnitName:                "subaddressName",
+	ComponentPostalCode:              "postCode",
+	ComponentPostalCodeExtension:     "postCodeExtension",
+	ComponentPostTown:                "postTownName",
+	ComponentSortingCode:             "sortingCode",
+	ComponentPostBox:                 "postBoxNumber",
+	ComponentPostBoxType:             "postBoxType",
+	ComponentPostOffice:              "postOfficeName",
+	ComponentLargeMailUser:           "largeMailUserName",
+}
+
+// iso19160AddressLine is the component type of the address lines of addresses made of address lines.
+const iso19160AddressLine = "addressLine"
+
+// iso19160Stages maps the lower-cased AttrCurrentStatus of an address to a lifecycle stage.
+var iso19160Stages = map[string]string{
+	"proposed": ISO19160Proposed, "planned": ISO19160Proposed,
+	"reserved": ISO19160Reserved,
+	"current":  ISO19160Current, "active": ISO19160Current, "valid": ISO19160Current,
+	"retired": ISO19160Retired, "old": ISO19160Retired, "historic": ISO19160Retired, "inactive": ISO19160Retired,
+}
+
+// ToISO19160 converts the address to an ISO 19160-1 address.
+//
+// Each component of the flat address becomes a component of the same value, eg. house_number an addressNumber,
+// see Flatten, and each address line an addressLine, but for a line of type Country which becomes a country component.
+// AttrCurrentStatus gives the lifecycle stage, eg. Current or Active to current and Old to retired,
+// AttrValidFromDate and AttrValidToDate the lifespan and AttrAddressType the class. The addressed object is
+// the sub-address of addresses with a unit, the building of addresses with a house number or a premise name,
+// and else the post box of addresses with one.
+//
+// The report lists what the model cannot carry, eg. AttrUsage and the statuses without a lifecycle stage.
+func ToISO19160(a *AddressDetails) (*ISO19160Address, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	addr := &ISO19160Address{
+		Class:      flat[ComponentAddressType],
+		ValidFrom:  flat[ComponentValidFrom],
+		ValidTo:    flat[ComponentValidTo],
+		Components: []*ISO19160Component{},
+	}
+	carried := []Component{ComponentAddressType, ComponentValidFrom, ComponentValidTo}
+	if stage, ok := iso19160Stages[strings.ToLower(flat[ComponentCurrentStatus])]; ok {
+		addr.LifecycleStage = stage
+		carried = append(carried, ComponentCurrentStatus)
+	}
+	for _, c := range FlatComponents {
+		if typ, ok := iso19160ComponentTypes[c]; ok && flat[c] != "" {
+			addr.Components = append(addr.Components, &ISO19160Component{Type: typ, Value: flat[c]})
+			carried = append(carried, c)
+		}
+	}
+	if a.AddressLines != nil {
+		for i, l := range *a.AddressLines {
+			carried = append(carried, AddressLineComponent(i+1))
+			switch {
+			case l == nil || l.Text == "":
+			case strings.EqualFold(l.AttrType, "Country"):
+				typ := iso19160ComponentTypes[ComponentCountryName]
+				if countryCodeRE.MatchString(l.Text) {
+					typ = iso19160ComponentTypes[ComponentCountryCode]
+				}
+				addr.Components = append(addr.Components, &ISO19160Component{Type: typ, Value: l.Text})
+				f.use(l, "AttrType")
+			default:
+				addr.Components = append(addr.Components, &ISO19160Component{Type: iso19160AddressLine, Value: l.Text})
+			}
+		}
+	}
+	switch {
+	case flat[ComponentUnit] != "" || flat[ComponentUnitName] != "":
+		addr.AddressedObject = &ISO19160AddressableObject{Type: "subaddress"}
+	case flat[ComponentHouseNumber] != "" || flat[ComponentPremiseName] != "" || flat[ComponentBuildingName] != "":
+		addr.AddressedObject = &ISO19160AddressableObject{Type: "building"}
+	case flat[ComponentPostBox] != "":
+		addr.AddressedObject = &ISO19160AddressableObject{Type: "postBox"}
+	}
+	return addr, f.dropped(carried...)
+}
+
+// FromISO19160 converts an ISO 19160-1 address to an address, see ToISO19160.
+//
+// The lifecycle stage sets AttrCurrentStatus, eg. current to Current, and the lifespan the validity dates.
+// Addresses with address lines keep their country components in a last line of type Country.
+// The addressed object is implied by the components and is not converted.
+//
+// The report lists the values that were not converted, as JSON Pointers, eg. /id, or /components/3
+// for a component of an unknown type or of a type already converted, or for the country name
+// of an address with lines, whose last line only keeps the country code.
+func FromISO19160(addr *ISO19160Address) (*AddressDetails, Report) {
+	if addr == nil {
+		return nil, Report{}
+	}
+	var r Report
+	components := map[string]Component{}
+	for c, typ := range iso19160ComponentTypes {
+		components[typ] = c
+	}
+	flat := Flat{
+		ComponentAddressType: strings.TrimSpace(addr.Class),
+		ComponentValidFrom:   strings.TrimSpace(addr.ValidFrom),
+		ComponentValidTo:     strings.TrimSpace(addr.ValidTo),
+	}
+	if stage := strings.ToLower(strings.TrimSpace(addr.LifecycleStage)); stage != "" {
+		switch stage {
+		case ISO19160Proposed, ISO19160Reserved, ISO19160Current, ISO19160Retired:
+			flat[ComponentCurrentStatus] = strings.ToUpper(stage[:1]) + stage[1:]
+		default:
+			r.Dropped = append(r.Dropped, "/lifecycleStage")
+		}
+	}
+	if addr.ID != "" {
+		r.Dropped = append(r.Dropped, "/id")
+	}
+	if o := addr.AddressedObject; o != nil && o.ID != "" {
+		r.Dropped = append(r.Dropped, "/addressedObject/id")
+	}
+	lines := 0
+	index := map[Component]int{} // index of the component each value was taken from
+	for i, comp := range addr.Components {
+		if comp == nil {
+			continue
+		}
+		v := strings.TrimSpace(comp.Value)
+		c, ok := components[comp.Type]
+		switch {
+		case v == "":
+		case comp.Type == iso19160AddressLine:
+			lines++
+			flat[AddressLineComponent(lines)] = v
+		case ok && flat[c] == "":
+			flat[c] = v
+			index[c] = i
+		default:
+			r.Dropped = append(r.Dropped, Path{"components", strconv.Itoa(i)}.String())
+		}
+	}
+	var country string
+	if lines > 0 {
+		for _, c := range []Component{ComponentCountryCode, ComponentCountryName} {
+			if country == "" {
+				country = flat[c]
+			} else if flat[c] != "" {
+				r.Dropped = append(r.Dropped, Path{"components", strconv.Itoa(index[c])}.String())
+			}
+			delete(flat, c)
+		}
+	}
+	a := flat.build()
+	if country != "" {
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: country})
+	}
+	sort.Strings(r.Dropped)
+	return a, r
+}
@@ -0,0 +1,58 @@
+package xal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestISO19160RoundTrip(t *testing.T) {
+	tests := []string{
+		`{"class":"Postal","lifecycleStage":"current","components":[{"type":"countryCode","value":"GB"},{"type":"thoroughfareName","value":"Downing Street"},{"type":"addressNumber","value":"10"},{"type":"postCode","value":"SW1A 2AA"},{"type":"postTownName","value":"London"}],"addressedObject":{"type":"building"}}`,
+		`{"components":[{"type":"addressLine","value":"1 Main Street"},{"type":"addressLine","value":"Oslo"},{"type":"countryCode","value":"NO"}]}`,
+	}
+	for _, doc := range tests {
+		t.Run(doc, func(t *testing.T) {
+			var addr ISO19160Address
+			if err := json.Unmarshal([]byte(doc), &addr); err != nil {
+				t.Fatal(err)
+			}
+			a, r := FromISO19160(&addr)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromISO19160 dropped %v", r.Dropped)
+			}
+			got, r := ToISO19160(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToISO19160 dropped %v", r.Dropped)
+			}
+			if b, _ := json.Marshal(got); string(b) != doc {
+				t.Errorf("got %s\nwant %s", b, doc)
+			}
+		})
+	}
+}
+
+func TestFromISO19160Report(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []*ISO19160Component
+		want       string
+	}{
+		{"unknown type", []*ISO19160Component{{Type: "localityName", Value: "Oslo"}, {Type: "landmark", Value: "Opera"}}, "/components/1"},
+		{"repeated type", []*ISO19160Component{{Type: "localityName", Value: "Oslo"}, {Type: "localityName", Value: "Bergen"}}, "/components/1"},
+		{"country name of lines", []*ISO19160Component{
+			{Type: "countryName", Value: "Norway"}, {Type: "addressLine", Value: "Oslo"}, {Type: "countryCode", Value: "NO"},
+		}, "/components/0"},
+		{"country name of components", []*ISO19160Component{
+			{Type: "countryName", Value: "Norway"}, {Type: "localityName", Value: "Oslo"}, {Type: "countryCode", Value: "NO"},
+		}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, r := FromISO19160(&ISO19160Address{Components: tt.components})
+			if got := strings.Join(r.Dropped, " "); got != tt.want {
+				t.Errorf("FromISO19160 dropped %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package xal
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ISO20022PostalAddress - The PostalAddress24 of ISO 20022 messages, such as the PstlAdr of a party in pacs.008, eg.
+//
+//	<PstlAdr><StrtNm>Main Street</StrtNm><BldgNb>1</BldgNb><PstCd>0150</PstCd><TwnNm>Oslo</TwnNm><Ctry>NO</Ctry></PstlAdr>
+//
+// Elements have no namespace, so that they take the namespace of the enclosing message.
+type ISO20022PostalAddress struct {
+	XMLName     xml.Name             `xml:"PstlAdr"`
+	AdrTp       *ISO20022AddressType `xml:"AdrTp,omitempty"`
+	Dept        string               `xml:"Dept,omitempty"`        // Department, Max70Text
+	SubDept     string               `xml:"SubDept,omitempty"`     // Sub-department, Max70Text
+	StrtNm      string               `xml:"StrtNm,omitempty"`      // Street name, Max70Text
+	BldgNb      string               `xml:"BldgNb,omitempty"`      // Building number, Max16Text
+	BldgNm      string               `xml:"BldgNm,omitempty"`      // Building name, Max35Text
+	Flr         string               `xml:"Flr,omitempty"`         // Floor, Max70Text
+	PstBx       string               `xml:"PstBx,omitempty"`       // Post box, Max16Text
+	Room        string               `xml:"Room,omitempty"`        // Max70Text
+	PstCd       string               `xml:"PstCd,omitempty"`       // Postal code, Max16Text
+	TwnNm       string               `xml:"TwnNm,omitempty"`       // Town name, Max35Text
+	TwnLctnNm   string               `xml:"TwnLctnNm,omitempty"`   // Town location name, eg. a district of the town, Max35Text
+	DstrctNm    string               `xml:"DstrctNm,omitempty"`    // District name, a subdivision of the country subdivision, Max35Text
+	CtrySubDvsn string               `xml:"CtrySubDvsn,omitempty"` // Country subdivision, eg. a state, Max35Text
+	Ctry        string               `xml:"Ctry,omitempty"`        // ISO 3166-1 alpha-2 country code
+	AdrLine     []string             `xml:"AdrLine,omitempty"`     // Max70Text, at most 7
+}
+
+// ISO20022AddressType - The AdrTp of a PostalAddress24, either a code, eg. ADDR, or a proprietary type.
+type ISO20022AddressType struct {
+	Cd    string                         `xml:"Cd,omitempty"` // ADDR, PBOX, HOME, BIZZ, MLTO or DLVY
+	Prtry *ISO20022GenericIdentification `xml:"Prtry,omitempty"`
+}
+
+// ISO20022GenericIdentification - A proprietary identification, the GenericIdentification30 of ISO 20022.
+type ISO20022GenericIdentification struct {
+	ID      string `xml:"Id"`   // Exact4AlphaNumericText
+	Issr    string `xml:"Issr"` // Issuer, Max35Text
+	SchmeNm string `xml:"SchmeNm,omitempty"`
+}
+
+// iso20022MaxAddressLines is the number of AdrLine of a PostalAddress24, and iso20022MaxHybridLines the
+// number allowed next to a structured address by the structured address rules applied by CBPR+ and SEPA from 2025.
+const (
+	iso20022MaxAddressLines = 7
+	iso20022MaxHybridLines  = 2
+)
+
+var (
+	iso20022CountryRE     = regexp.MustCompile(`^[A-Z]{2}$`)
+	iso20022ProprietaryRE = regexp.MustCompile(`^[a-zA-Z0-9]{4}$`) // Exact4AlphaNumericText
+)
+
+// Validate checks the address against the length rules of PostalAddress24 and the structured address rules
+// applied from 2025: the town name and the country are required, and at most two address lines may complete
+// a structured address. The AdrTp must be a known code, or a proprietary type of 4 letters or digits
+// with its issuer.
+//
+// It returns nil or a ValidationErrors naming the offending elements.
+func (p *ISO20022PostalAddress) Validate() error {
+	var errs ValidationErrors
+	check := func(field, value string, max int) {
+		if n := utf8.RuneCountInString(value); n > max {
+			errs = append(errs, &ValidationError{Field: field, Reason: fmt.Sprintf("%q is %d characters long, the maximum is %d", value, n, max)})
+		}
+	}
+	check("Dept", p.Dept, 70)
+	check("SubDept", p.SubDept, 70)
+	check("StrtNm", p.StrtNm, 70)
+	check("BldgNb", p.BldgNb, 16)
+	check("BldgNm", p.BldgNm, 35)
+	check("Flr", p.Flr, 70)
+	check("PstBx", p.PstBx, 16)
+	check("Room", p.Room, 70)
+	check("PstCd", p.PstCd, 16)
+	check("TwnNm", p.TwnNm, 35)
+	check("TwnLctnNm", p.TwnLctnNm, 35)
+	check("DstrctNm", p.DstrctNm, 35)
+	check("CtrySubDvsn", p.CtrySubDvsn, 35)
+	if t := p.AdrTp; t != nil {
+		switch {
+		case (t.Cd == "") == (t.Prtry == nil):
+			errs = append(errs, &ValidationError{Field: "AdrTp", Reason: "either a code or a proprietary type is required"})
+		case t.Prtry == nil:
+			if iso20022AttrAddressTypes[t.Cd] == "" {
+				errs = append(errs, &ValidationError{Path: Path{"AdrTp"}, Field: "Cd", Reason: fmt.Sprintf("%q is not an address type code", t.Cd)})
+			}
+		default:
+			if !iso20022ProprietaryRE.MatchString(t.Prtry.ID) {
+				errs = append(errs, &ValidationError{Path: Path{"AdrTp", "Prtry"}, Field: "Id", Reason: fmt.Sprintf("%q is not 4 letters or digits", t.Prtry.ID)})
+			}
+			if t.Prtry.Issr == "" {
+				errs = append(errs, &ValidationError{Path: Path{"AdrTp", "Prtry"}, Field: "Issr", Reason: "issuer is required"})
+			} else if n := utf8.RuneCountInString(t.Prtry.Issr); n > 35 {
+				errs = append(errs, &ValidationError{Path: Path{"AdrTp", "Prtry"}, Field: "Issr", Reason: fmt.Sprintf("%q is %d characters long, the maximum is %d", t.Prtry.Issr, n, 35)})
+			}
+		}
+	}
+	for i, l := range p.AdrLine {
+		if n := utf8.RuneCountInString(l); n > 70 {
+			errs = append(errs, &ValidationError{Path: Path{"AdrLine"}, Field: strconv.Itoa(i), Reason: fmt.Sprintf("%q is %d characters long, the maximum is %d", l, n, 70)})
+		}
+	}
+	if len(p.AdrLine) > iso20022MaxAddressLines {
+		errs = append(errs, &ValidationError{Field: "AdrLine", Reason: fmt.Sprintf("%d lines, the maximum is %d", len(p.AdrLine), iso20022MaxAddressLines)})
+	} else if len(p.AdrLine) > iso20022MaxHybridLines && p.TwnNm != "" {
+		errs = append(errs, &ValidationError{Field: "AdrLine", Reason: fmt.Sprintf("%d lines, a structured address allows %d", len(p.AdrLine), iso20022MaxHybridLines)})
+	}
+	if p.TwnNm == "" {
+		errs = append(errs, &ValidationError{Field: "TwnNm", Reason: "town name is required"})
+	}
+	switch {
+	case p.Ctry == "":
+		errs = append(errs, &ValidationError{Field: "Ctry", Reason: "country is required"})
+	case !iso20022CountryRE.MatchString(p.Ctry):
+		errs = append(errs, &ValidationError{Field: "Ctry", Reason: fmt.Sprintf("%q is not an ISO 3166-1 alpha-2 code", p.Ctry)})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// iso20022AddressTypes maps the lower-cased AttrAddressType of an address to an AdrTp code, and
+// iso20022AttrAddressTypes an AdrTp code to an AttrAddressType.
+var (
+	iso20022AddressTypes = map[string]string{
+		"postal": "ADDR", "pobox": "PBOX", "po box": "PBOX", "residential": "HOME", "home": "HOME",
+		"business": "BIZZ", "mailto": "MLTO", "deliveryto": "DLVY", "delivery": "DLVY",
+		"addr": "ADDR", "pbox": "PBOX", "bizz": "BIZZ", "mlto": "MLTO", "dlvy": "DLVY",
+	}
+	iso20022AttrAddressTypes = map[string]string{
+		"ADDR": "Postal", "PBOX": "POBox", "HOME": "Residential", "BIZZ": "Business", "MLTO": "MailTo", "DLVY": "DeliveryTo",
+	}
+)
+
+// ToISO20022 converts the address to a PostalAddress24, and validates it, see ISO20022PostalAddress.Validate.
+//
+// The building name, or else the premise name, goes to BldgNm, the premise name to an AdrLine when both are set,
+// the sub-premise of type Floor to Flr and the other one to Room, eg. Apt 4, the dependent locality to TwnLctnNm
+// and the administrative area name of type District to DstrctNm. AttrAddressType is mapped to AdrTp, eg. Business
+// to BIZZ; other address types have no code, and a proprietary type would need an issuer, so they are dropped.
+// Addresses made of address lines keep their lines, but for a line of type Country which becomes the country.
+//
+// The report lists what PostalAddress24 cannot carry, eg. dependent thoroughfares and sorting codes.
+// On error, the address is nil and the report still lists the losses.
+func ToISO20022(a *AddressDetails) (*ISO20022PostalAddress, Report, error) {
+	if a == nil {
+		return nil, Report{}, nil
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	p := &ISO20022PostalAddress{
+		StrtNm: joinNonEmpty([]string{
+			flat[ComponentStreetPreDirection], flat[ComponentStreetLeadingType], flat[ComponentStreetName],
+			flat[ComponentStreetType], flat[ComponentStreetPostDirection],
+		}, " "),
+		BldgNb:    flat[ComponentHouseNumber] + flat[ComponentHouseNumberSuffix],
+		BldgNm:    flat[ComponentBuildingName],
+		PstBx:     flat[ComponentPostBox],
+		PstCd:     joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		TwnNm:     flat[ComponentLocality],
+		TwnLctnNm: flat[ComponentDependentLocality],
+		Ctry:      flat[ComponentCountryCode],
+	}
+	carried := []Component{
+		ComponentStreetPreDirection, ComponentStreetLeadingType, ComponentStreetName, ComponentStreetType,
+		ComponentStreetPostDirection, ComponentHouseNumber, ComponentHouseNumberSuffix, ComponentBuildingName,
+		ComponentPremiseName, ComponentPostBox, ComponentPostalCode, ComponentPostalCodeExtension,
+		ComponentLocality, ComponentDependentLocality, ComponentCountryCode, ComponentAdminArea,
+	}
+	carried = append(carried, unitComponents...)
+	if name := flat[ComponentPremiseName]; p.BldgNm == "" {
+		p.BldgNm = name
+	} else if name != "" {
+		p.AdrLine = append(p.AdrLine, name)
+	}
+
+	if code, ok := iso20022AddressTypes[strings.ToLower(flat[ComponentAddressType])]; ok {
+		p.AdrTp = &ISO20022AddressType{Cd: code}
+		carried = append(carried, ComponentAddressType)
+	}
+	p.CtrySubDvsn, p.DstrctNm = f.adminAreaNames()
+	if pr := a.premise(); pr != nil {
+		for _, sp := range pr.SubPremise {
+			if sp == nil {
+				continue
+			}
+			var number, name string
+			if len(sp.SubPremiseNumber) > 0 {
+				number = sp.SubPremiseNumber[0].Text
+			}
+			if len(sp.SubPremiseName) > 0 {
+				name = sp.SubPremiseName[0].Text
+			}
+			switch {
+			case strings.EqualFold(sp.AttrType, "Floor") && p.Flr == "":
+				p.Flr = joinNonEmpty([]string{number, name}, " ")
+				f.use(sp, "AttrType")
+			case !strings.EqualFold(sp.AttrType, "Floor") && p.Room == "":
+				p.Room = joinNonEmpty([]string{sp.AttrType, number, name}, " ")
+				f.use(sp, "AttrType")
+			default:
+				continue
+			}
+			if len(sp.SubPremiseNumber) > 0 {
+				f.use(sp.SubPremiseNumber[0], "Text")
+			}
+			if len(sp.SubPremiseName) > 0 {
+				f.use(sp.SubPremiseName[0], "Text")
+			}
+		}
+	}
+	if a.AddressLines != nil {
+		for i, l := range *a.AddressLines {
+			carried = append(carried, AddressLineComponent(i+1))
+			switch {
+			case l == nil || l.Text == "":
+			case strings.EqualFold(l.AttrType, "Country") && p.Ctry == "":
+				p.Ctry = strings.ToUpper(l.Text)
+				f.use(l, "AttrType")
+			default:
+				p.AdrLine = append(p.AdrLine, l.Text)
+			}
+		}
+	}
+	r := f.dropped(carried...)
+	if err := p.Validate(); err != nil {
+		return nil, r, err
+	}
+	return p, r, nil
+}
+
+// FromISO20022 converts a PostalAddress24 to an address.
+//
+// BldgNm becomes the building name, Flr a sub-premise of type Floor following the one of Room, parsed as
+// for FromSchemaOrg, and DstrctNm an administrative area name of type District. AdrTp codes are mapped to
+// AttrAddressType, eg. BIZZ to Business.
+// The address lines become the premise name, or the address lines of addresses without other elements
+// but the country, which is then kept in a last line of type Country.
+//
+// The report lists the elements that were not converted, as JSON Pointers named after the elements,
+// eg. /Dept and /SubDept, or /AdrTp/Prtry for a proprietary address type.
+func FromISO20022(p *ISO20022PostalAddress) (*AddressDetails, Report) {
+	if p == nil {
+		return nil, Report{}
+	}
+	var r Report
+	for _, d := range []struct{ name, value string }{{"Dept", p.Dept}, {"SubDept", p.SubDept}} {
+		if strings.TrimSpace(d.value) != "" {
+			r.Dropped = append(r.Dropped, Path{d.name}.String())
+		}
+	}
+	flat := Flat{
+		ComponentStreetName:        strings.TrimSpace(p.StrtNm),
+		ComponentHouseNumber:       strings.TrimSpace(p.BldgNb),
+		ComponentBuildingName:      strings.TrimSpace(p.BldgNm),
+		ComponentPostBox:           strings.TrimSpace(p.PstBx),
+		ComponentPostalCode:        strings.TrimSpace(p.PstCd),
+		ComponentLocality:          strings.TrimSpace(p.TwnNm),
+		ComponentDependentLocality: strings.TrimSpace(p.TwnLctnNm),
+		ComponentAdminArea:         strings.TrimSpace(p.CtrySubDvsn),
+	}
+	room := strings.TrimSpace(p.Room)
+	if m := unitRE.FindStringSubmatch(room); m != nil {
+		flat[ComponentUnitType], flat[ComponentUnit] = m[1], m[2]
+	} else {
+		flat[ComponentUnit] = room
+	}
+	structured := p.Flr != "" || p.DstrctNm != ""
+	for _, v := range flat {
+		structured = structured || v != ""
+	}
+	country := strings.ToUpper(strings.TrimSpace(p.Ctry))
+	lines := nonEmpty(p.AdrLine)
+	if structured {
+		flat[ComponentCountryCode] = country
+		flat[ComponentPremiseName] = strings.Join(lines, ", ")
+	} else {
+		for i, l := range lines {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	}
+	if t := p.AdrTp; t != nil {
+		if v := iso20022AttrAddressTypes[strings.ToUpper(strings.TrimSpace(t.Cd))]; v != "" {
+			flat[ComponentAddressType] = v
+		} else if t.Cd != "" {
+			r.Dropped = append(r.Dropped, "/AdrTp/Cd")
+		}
+		if t.Prtry != nil {
+			r.Dropped = append(r.Dropped, "/AdrTp/Prtry")
+		}
+	}
+	a := flat.build()
+	s := a.In(BranchCountry)
+	if !structured && country != "" {
+		if a.AddressLines == nil {
+			a.AddressLines = &AddressLines{}
+		}
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: country})
+	}
+	if d := strings.TrimSpace(p.DstrctNm); d != "" {
+		s.district(d)
+	}
+	if flr := strings.TrimSpace(p.Flr); flr != "" {
+		pr := s.premise()
+		pr.SubPremise = append(pr.SubPremise, &SubPremise{AttrType: "Floor", SubPremiseNumber: []*SubPremiseNumber{{Text: flr}}})
+	}
+	return a, r
+}
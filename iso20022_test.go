@@ -0,0 +1,105 @@
+package xal
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestISO20022RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *ISO20022PostalAddress
+	}{
+		{"structured", &ISO20022PostalAddress{
+			AdrTp: &ISO20022AddressType{Cd: "BIZZ"}, StrtNm: "Main Street", BldgNb: "1", PstCd: "0150",
+			TwnNm: "Oslo", TwnLctnNm: "Sentrum", Ctry: "NO",
+		}},
+		{"building and room", &ISO20022PostalAddress{
+			StrtNm: "Main Street", BldgNb: "1", BldgNm: "Tower", Room: "Apt 4", PstCd: "12345",
+			TwnNm: "Springfield", CtrySubDvsn: "IL", DstrctNm: "Sangamon", Ctry: "US",
+		}},
+		{"hybrid", &ISO20022PostalAddress{BldgNm: "Tower", TwnNm: "Oslo", Ctry: "NO", AdrLine: []string{"Main Street 1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r := FromISO20022(tt.p)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromISO20022 dropped %v", r.Dropped)
+			}
+			got, r, err := ToISO20022(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToISO20022 dropped %v", r.Dropped)
+			}
+			want, _ := xml.Marshal(tt.p)
+			if b, _ := xml.Marshal(got); string(b) != string(want) {
+				t.Errorf("got %s\nwant %s", b, want)
+			}
+		})
+	}
+}
+
+func TestISO20022Report(t *testing.T) {
+	p := &ISO20022PostalAddress{
+		AdrTp: &ISO20022AddressType{Prtry: &ISO20022GenericIdentification{ID: "WORK", Issr: "Bank"}},
+		Dept:  "Accounts", SubDept: "Payables", TwnNm: "Oslo", Ctry: "NO",
+	}
+	a, r := FromISO20022(p)
+	if got, want := strings.Join(r.Dropped, " "), "/Dept /SubDept /AdrTp/Prtry"; got != want {
+		t.Errorf("FromISO20022 dropped %q, want %q", got, want)
+	}
+	if a.AttrAddressType != "" || a.PostalServiceElements != nil {
+		t.Errorf("FromISO20022 kept the address type %q or the departments %+v", a.AttrAddressType, a.PostalServiceElements)
+	}
+
+	a = &AddressDetails{
+		AttrAddressType: "Headquarters",
+		Country:         &Country{CountryNameCode: []*CountryNameCode{{Text: "NO"}}, Locality: &Locality{LocalityName: []*LocalityName{{Text: "Oslo"}}}},
+	}
+	got, r, err := ToISO20022(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.AdrTp != nil {
+		t.Errorf("ToISO20022 wrote the address type %+v", got.AdrTp)
+	}
+	if want := "/attr_address_type"; strings.Join(r.Dropped, " ") != want {
+		t.Errorf("ToISO20022 dropped %v, want [%s]", r.Dropped, want)
+	}
+}
+
+func TestISO20022Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		adrTp *ISO20022AddressType
+		want  []string // fields of the errors
+	}{
+		{"code", &ISO20022AddressType{Cd: "HOME"}, nil},
+		{"unknown code", &ISO20022AddressType{Cd: "WORK"}, []string{"/AdrTp/Cd"}},
+		{"proprietary", &ISO20022AddressType{Prtry: &ISO20022GenericIdentification{ID: "WRK1", Issr: "Bank"}}, nil},
+		{"proprietary without issuer", &ISO20022AddressType{Prtry: &ISO20022GenericIdentification{ID: "WRK1"}}, []string{"/AdrTp/Prtry/Issr"}},
+		{"proprietary id too long", &ISO20022AddressType{Prtry: &ISO20022GenericIdentification{ID: "WORKS", Issr: "Bank"}}, []string{"/AdrTp/Prtry/Id"}},
+		{"proprietary id not alphanumeric", &ISO20022AddressType{Prtry: &ISO20022GenericIdentification{ID: "W-RK", Issr: "Bank"}}, []string{"/AdrTp/Prtry/Id"}},
+		{"neither", &ISO20022AddressType{}, []string{"/AdrTp"}},
+		{"both", &ISO20022AddressType{Cd: "HOME", Prtry: &ISO20022GenericIdentification{ID: "WRK1", Issr: "Bank"}}, []string{"/AdrTp"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ISO20022PostalAddress{AdrTp: tt.adrTp, TwnNm: "Oslo", Ctry: "NO"}
+			var got []string
+			var errs ValidationErrors
+			if err := p.Validate(); errors.As(err, &errs) {
+				for _, e := range errs {
+					got = append(got, append(append(Path{}, e.Path...), e.Field).String())
+				}
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("Validate() failed on %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
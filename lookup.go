@@ -0,0 +1,249 @@
+package xal
+
+import "strings"
+
+// The lookups below find a component wherever the spec allows it to be placed.
+// They are all nil-safe and return nil when the component is absent.
+
+func (a *AddressDetails) administrativeArea() *AdministrativeArea {
+	switch {
+	case a == nil:
+		return nil
+	case a.AdministrativeArea != nil:
+		return a.AdministrativeArea
+	case a.Country != nil:
+		return a.Country.AdministrativeArea
+	}
+	return nil
+}
+
+func (a *AddressDetails) locality() *Locality {
+	switch {
+	case a == nil:
+		return nil
+	case a.Locality != nil:
+		return a.Locality
+	case a.Country != nil && a.Country.Locality != nil:
+		return a.Country.Locality
+	}
+	if aa := a.administrativeArea(); aa != nil {
+		return aa.Locality
+	}
+	return nil
+}
+
+func (a *AddressDetails) dependentLocality() *DependentLocality {
+	if l := a.locality(); l != nil && l.DependentLocality != nil {
+		return l.DependentLocality
+	}
+	if t := a.thoroughfare(); t != nil {
+		return t.DependentLocality
+	}
+	return nil
+}
+
+func (a *AddressDetails) thoroughfare() *Thoroughfare {
+	if l := a.locality(); l != nil {
+		if l.Thoroughfare != nil {
+			return l.Thoroughfare
+		}
+		for dl := l.DependentLocality; dl != nil; dl = dl.DependentLocality {
+			if dl.Thoroughfare != nil {
+				return dl.Thoroughfare
+			}
+		}
+	}
+	switch {
+	case a == nil:
+		return nil
+	case a.Country != nil && a.Country.Thoroughfare != nil:
+		return a.Country.Thoroughfare
+	}
+	return a.Thoroughfare
+}
+
+func (a *AddressDetails) premise() *Premise {
+	if t := a.thoroughfare(); t != nil && t.Premise != nil {
+		return t.Premise
+	}
+	if l := a.locality(); l != nil {
+		if l.Premise != nil {
+			return l.Premise
+		}
+		for dl := l.DependentLocality; dl != nil; dl = dl.DependentLocality {
+			if dl.Premise != nil {
+				return dl.Premise
+			}
+		}
+	}
+	return nil
+}
+
+func (a *AddressDetails) postalCode() *PostalCode {
+	if l := a.locality(); l != nil && l.PostalCode != nil {
+		return l.PostalCode
+	}
+	if t := a.thoroughfare(); t != nil && t.PostalCode != nil {
+		return t.PostalCode
+	}
+	if p := a.premise(); p != nil && p.PostalCode != nil {
+		return p.PostalCode
+	}
+	if pb := a.postBox(); pb != nil && pb.PostalCode != nil {
+		return pb.PostalCode
+	}
+	if po := a.postOffice(); po != nil && po.PostalCode != nil {
+		return po.PostalCode
+	}
+	return nil
+}
+
+func (a *AddressDetails) postBox() *PostBox {
+	if l := a.locality(); l != nil {
+		return l.PostBox
+	}
+	return nil
+}
+
+func (a *AddressDetails) postOffice() *PostOffice {
+	if l := a.locality(); l != nil && l.PostOffice != nil {
+		return l.PostOffice
+	}
+	for dl := a.dependentLocality(); dl != nil; dl = dl.DependentLocality {
+		if dl.PostOffice != nil {
+			return dl.PostOffice
+		}
+	}
+	return nil
+}
+
+func (a *AddressDetails) subPremise() *SubPremise {
+	if p := a.premise(); p != nil && len(p.SubPremise) > 0 {
+		return p.SubPremise[0]
+	}
+	return nil
+}
+
+// street returns the full thoroughfare, including its leading and trailing types.
+func (t *Thoroughfare) street() string {
+	if t == nil {
+		return ""
+	}
+	var parts []string
+	if t.ThoroughfarePreDirection != nil {
+		parts = append(parts, t.ThoroughfarePreDirection.Text)
+	}
+	if t.ThoroughfareLeadingType != nil {
+		parts = append(parts, t.ThoroughfareLeadingType.Text)
+	}
+	for _, n := range t.ThoroughfareName {
+		parts = append(parts, n.Text)
+	}
+	if t.ThoroughfareTrailingType != nil {
+		parts = append(parts, t.ThoroughfareTrailingType.Text)
+	}
+	if t.ThoroughfarePostDirection != nil {
+		parts = append(parts, t.ThoroughfarePostDirection.Text)
+	}
+	return joinNonEmpty(parts, " ")
+}
+
+func (t *Thoroughfare) name() string {
+	if t == nil || len(t.ThoroughfareName) == 0 {
+		return ""
+	}
+	return t.ThoroughfareName[0].Text
+}
+
+func (c *Country) code() string {
+	if c == nil || len(c.CountryNameCode) == 0 {
+		return ""
+	}
+	return c.CountryNameCode[0].Text
+}
+
+func (c *Country) name() string {
+	if c == nil || len(c.CountryName) == 0 {
+		return ""
+	}
+	return c.CountryName[0].Text
+}
+
+func (l *Locality) name() string {
+	if l == nil || len(l.LocalityName) == 0 {
+		return ""
+	}
+	return l.LocalityName[0].Text
+}
+
+func (aa *AdministrativeArea) name() string {
+	if aa == nil || len(aa.AdministrativeAreaName) == 0 {
+		return ""
+	}
+	return aa.AdministrativeAreaName[0].Text
+}
+
+func (dl *DependentLocality) name() string {
+	if dl == nil || len(dl.DependentLocalityName) == 0 {
+		return ""
+	}
+	return dl.DependentLocalityName[0].Text
+}
+
+func (pc *PostalCode) number() string {
+	if pc == nil || len(pc.PostalCodeNumber) == 0 {
+		return ""
+	}
+	return pc.PostalCodeNumber[0].Text
+}
+
+// number returns the first premise number and its suffix, eg. 12A.
+func (p *Premise) number() string {
+	if p == nil || len(p.PremiseNumber) == 0 {
+		return ""
+	}
+	if len(p.PremiseNumberSuffix) == 0 {
+		return p.PremiseNumber[0].Text
+	}
+	return p.PremiseNumber[0].Text + p.PremiseNumberSuffix[0].Text
+}
+
+// number returns the first thoroughfare number and its suffix, eg. 12A.
+func (t *Thoroughfare) number() string {
+	if t == nil || len(t.ThoroughfareNumber) == 0 {
+		return ""
+	}
+	if len(t.ThoroughfareNumberSuffix) == 0 {
+		return t.ThoroughfareNumber[0].Text
+	}
+	return t.ThoroughfareNumber[0].Text + t.ThoroughfareNumberSuffix[0].Text
+}
+
+// unit returns the identifiers of a sub-premise and of the sub-premises nested in it, eg. "4 B".
+func (sp *SubPremise) unit() string {
+	if sp == nil {
+		return ""
+	}
+	var parts []string
+	for _, n := range sp.SubPremiseName {
+		parts = append(parts, n.Text)
+	}
+	for _, n := range sp.SubPremiseNumber {
+		parts = append(parts, n.Text)
+	}
+	for _, n := range sp.SubPremiseNumberSuffix {
+		parts = append(parts, n.Text)
+	}
+	parts = append(parts, sp.SubPremise.unit())
+	return joinNonEmpty(parts, " ")
+}
+
+func joinNonEmpty(parts []string, sep string) string {
+	var out []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, sep)
+}
@@ -0,0 +1,176 @@
+package xal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MatchWeights - Relative importance of each component when two addresses are compared.
+//
+// A weight of zero leaves the component out of the score.
+type MatchWeights map[Component]float64
+
+// DefaultMatchWeights are the weights used by NewMatcher.
+var DefaultMatchWeights = MatchWeights{
+	ComponentPostalCode:  0.25,
+	ComponentStreetName:  0.30,
+	ComponentHouseNumber: 0.20,
+	ComponentUnit:        0.10,
+	ComponentLocality:    0.15,
+}
+
+// matchComponents lists the compared components, in the order they are reported.
+var matchComponents = []Component{
+	ComponentPostalCode,
+	ComponentStreetName,
+	ComponentHouseNumber,
+	ComponentUnit,
+	ComponentLocality,
+}
+
+// Matcher - Scores how likely two addresses are to refer to the same delivery point.
+type Matcher struct {
+	Weights   MatchWeights
+	Threshold float64 // Score at or above which two addresses are considered duplicates
+	Missing   float64 // Score given to a component present on one side only
+}
+
+// NewMatcher returns a Matcher using DefaultMatchWeights and a duplicate threshold of 0.85.
+func NewMatcher() *Matcher {
+	return &Matcher{Weights: DefaultMatchWeights, Threshold: 0.85}
+}
+
+// ComponentMatch - The comparison of a single component of two addresses.
+type ComponentMatch struct {
+	Component   Component
+	A, B        string  // Normalised values that were compared
+	Score       float64 // Similarity between 0 and 1
+	Weight      float64
+	Explanation string
+}
+
+// MatchResult - The outcome of comparing two addresses.
+type MatchResult struct {
+	Score      float64 // Weighted similarity between 0 and 1
+	Duplicate  bool    // Score reached the matcher threshold
+	Components []ComponentMatch
+}
+
+// Duplicate - A pair of addresses of a list that were found to be duplicates.
+type Duplicate struct {
+	I, J   int // Indexes of the addresses in the list, I < J
+	Result MatchResult
+}
+
+// Match normalises both addresses and compares them component by component.
+//
+// Components missing on both sides are reported but do not count towards the score.
+func (m *Matcher) Match(a, b *AddressDetails) MatchResult {
+	var r MatchResult
+	var total float64
+	for _, c := range matchComponents {
+		w := m.Weights[c]
+		if w <= 0 {
+			continue
+		}
+		cm := m.compare(c, matchValue(a, c), matchValue(b, c))
+		cm.Weight = w
+		r.Components = append(r.Components, cm)
+		if cm.A == "" && cm.B == "" {
+			continue
+		}
+		r.Score += w * cm.Score
+		total += w
+	}
+	if total > 0 {
+		r.Score /= total
+	}
+	r.Duplicate = total > 0 && r.Score >= m.Threshold
+	return r
+}
+
+// Duplicates compares every pair of addresses of list and returns those that are duplicates.
+func (m *Matcher) Duplicates(list []*AddressDetails) []Duplicate {
+	var dups []Duplicate
+	for i := range list {
+		for j := i + 1; j < len(list); j++ {
+			if r := m.Match(list[i], list[j]); r.Duplicate {
+				dups = append(dups, Duplicate{I: i, J: j, Result: r})
+			}
+		}
+	}
+	return dups
+}
+
+// matchValue returns the value of c compared by Match.
+// Streets are compared with their types and directions, so that "Market St" matches "Market Street",
+// and postal codes with their extension, so that 94105-1420 and 94105-1421 differ.
+func matchValue(a *AddressDetails, c Component) string {
+	switch c {
+	case ComponentStreetName:
+		return a.thoroughfare().street()
+	case ComponentPostalCode:
+		pc := a.postalCode()
+		if pc == nil || len(pc.PostalCodeNumberExtension) == 0 {
+			return pc.number()
+		}
+		return joinNonEmpty([]string{pc.number(), pc.PostalCodeNumberExtension[0].Text}, "-")
+	}
+	return a.component(c)
+}
+
+func (m *Matcher) compare(c Component, a, b string) ComponentMatch {
+	cm := ComponentMatch{Component: c}
+	switch c {
+	case ComponentPostalCode, ComponentHouseNumber, ComponentUnit:
+		cm.A, cm.B = normalizeCode(a), normalizeCode(b)
+	default:
+		cm.A, cm.B = normalize(a), normalize(b)
+	}
+	switch {
+	case cm.A == "" && cm.B == "":
+		cm.Explanation = "missing on both sides"
+		return cm
+	case cm.A == "" || cm.B == "":
+		cm.Score = m.Missing
+		cm.Explanation = "missing on one side"
+		return cm
+	case cm.A == cm.B:
+		cm.Score = 1
+		cm.Explanation = "identical"
+		return cm
+	}
+	switch c {
+	case ComponentPostalCode:
+		// A ZIP code and its ZIP+4 form, eg. 94105 and 94105-1420, designate the same area.
+		if zipPlus4(cm.A, cm.B) {
+			cm.Score = 0.9
+			cm.Explanation = "one postal code extends the other"
+			return cm
+		}
+		cm.Explanation = "different postal codes"
+	case ComponentHouseNumber, ComponentUnit:
+		cm.Explanation = fmt.Sprintf("different %s", strings.ReplaceAll(string(c), "_", " "))
+	default:
+		cm.Score = similarity(cm.A, cm.B)
+		cm.Explanation = fmt.Sprintf("edit distance similarity %.2f", cm.Score)
+	}
+	return cm
+}
+
+// zipPlus4 reports whether the normalised postal codes are a ZIP code and its ZIP+4 form, eg. 94105 and 941051420.
+// Other prefixes, eg. 9 and 94105, are truncated codes rather than the same area.
+func zipPlus4(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(a) != 5 || len(b) != 9 || !strings.HasPrefix(b, a) {
+		return false
+	}
+	for _, r := range b {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package xal
+
+import (
+	"maps"
+	"math"
+	"testing"
+)
+
+func flatAddress(t *testing.T, f Flat) *AddressDetails {
+	t.Helper()
+	a, err := Unflatten(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestMatcherComponents(t *testing.T) {
+	base := Flat{
+		ComponentCountryCode: "US", ComponentLocality: "San Francisco", ComponentStreetName: "Market",
+		ComponentStreetType: "St", ComponentHouseNumber: "1", ComponentPostalCode: "94105",
+	}
+	with := func(c Component, v string) Flat {
+		f := maps.Clone(base)
+		f[c] = v
+		return f
+	}
+	tests := []struct {
+		name  string
+		a, b  Flat
+		c     Component
+		score float64
+	}{
+		{"identical", base, base, ComponentPostalCode, 1},
+		{"street type spelled out", base, with(ComponentStreetType, "Street"), ComponentStreetName, 1},
+		{"ZIP and ZIP+4", base, with(ComponentPostalCodeExtension, "1420"), ComponentPostalCode, 0.9},
+		{"ZIP+4 in the code", base, with(ComponentPostalCode, "94105-1420"), ComponentPostalCode, 0.9},
+		{"same extensions", with(ComponentPostalCodeExtension, "1420"), with(ComponentPostalCode, "94105-1420"), ComponentPostalCode, 1},
+		{"different extensions", with(ComponentPostalCodeExtension, "1420"), with(ComponentPostalCodeExtension, "1421"), ComponentPostalCode, 0},
+		{"truncated code", base, with(ComponentPostalCode, "9"), ComponentPostalCode, 0},
+		{"different number", base, with(ComponentHouseNumber, "11"), ComponentHouseNumber, 0},
+		{"missing unit", base, with(ComponentUnit, "4"), ComponentUnit, 0},
+		{"typo", base, with(ComponentLocality, "San Fransisco"), ComponentLocality, 1 - 1.0/13},
+	}
+	m := NewMatcher()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := m.Match(flatAddress(t, tt.a), flatAddress(t, tt.b))
+			for _, cm := range r.Components {
+				if cm.Component != tt.c {
+					continue
+				}
+				if math.Abs(cm.Score-tt.score) > 1e-9 {
+					t.Errorf("%s score = %v (%s), want %v", tt.c, cm.Score, cm.Explanation, tt.score)
+				}
+				return
+			}
+			t.Fatalf("%s was not compared", tt.c)
+		})
+	}
+}
+
+func TestMatcherScore(t *testing.T) {
+	a := Flat{ComponentStreetName: "Market St", ComponentHouseNumber: "1", ComponentPostalCode: "94105", ComponentLocality: "San Francisco"}
+	b := Flat{ComponentStreetName: "Market St", ComponentHouseNumber: "3", ComponentPostalCode: "94105", ComponentLocality: "San Francisco"}
+	m := NewMatcher()
+	r := m.Match(flatAddress(t, a), flatAddress(t, b))
+	// The unit is missing on both sides: the score is that of the other components, the house number failing.
+	want := (0.25 + 0.30 + 0.15) / 0.90
+	if math.Abs(r.Score-want) > 1e-9 || r.Duplicate {
+		t.Errorf("Match() = %v, duplicate %v, want %v and no duplicate", r.Score, r.Duplicate, want)
+	}
+	if r := m.Match(flatAddress(t, a), flatAddress(t, a)); r.Score != 1 || !r.Duplicate {
+		t.Errorf("Match() of an address with itself = %v, duplicate %v", r.Score, r.Duplicate)
+	}
+	if r := m.Match(&AddressDetails{}, &AddressDetails{}); r.Score != 0 || r.Duplicate {
+		t.Errorf("Match() of empty addresses = %v, duplicate %v", r.Score, r.Duplicate)
+	}
+	dups := m.Duplicates([]*AddressDetails{flatAddress(t, a), flatAddress(t, b), flatAddress(t, a)})
+	if len(dups) != 1 || dups[0].I != 0 || dups[0].J != 2 {
+		t.Errorf("Duplicates() = %+v, want the pair 0, 2", dups)
+	}
+}
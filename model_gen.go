@@ -0,0 +1,2670 @@
+// Code generated by gen_model.go; DO NOT EDIT.
+
+package xal
+
+import "strconv"
+
+type (
+	// XALVisitor - Implemented by visitors passed to Visit that handle *XAL nodes.
+	XALVisitor interface {
+		VisitXAL(path Path, node *XAL) error
+	}
+
+	// AddressVisitor - Implemented by visitors passed to Visit that handle *Address nodes.
+	AddressVisitor interface {
+		VisitAddress(path Path, node *Address) error
+	}
+
+	// AddressDetailsVisitor - Implemented by visitors passed to Visit that handle *AddressDetails nodes.
+	AddressDetailsVisitor interface {
+		VisitAddressDetails(path Path, node *AddressDetails) error
+	}
+
+	// AddressIdentifierVisitor - Implemented by visitors passed to Visit that handle *AddressIdentifier nodes.
+	AddressIdentifierVisitor interface {
+		VisitAddressIdentifier(path Path, node *AddressIdentifier) error
+	}
+
+	// AddressLatitudeVisitor - Implemented by visitors passed to Visit that handle *AddressLatitude nodes.
+	AddressLatitudeVisitor interface {
+		VisitAddressLatitude(path Path, node *AddressLatitude) error
+	}
+
+	// AddressLatitudeDirectionVisitor - Implemented by visitors passed to Visit that handle *AddressLatitudeDirection nodes.
+	AddressLatitudeDirectionVisitor interface {
+		VisitAddressLatitudeDirection(path Path, node *AddressLatitudeDirection) error
+	}
+
+	// AddressLineVisitor - Implemented by visitors passed to Visit that handle *AddressLine nodes.
+	AddressLineVisitor interface {
+		VisitAddressLine(path Path, node *AddressLine) error
+	}
+
+	// AddressLinesVisitor - Implemented by visitors passed to Visit that handle *AddressLines nodes.
+	AddressLinesVisitor interface {
+		VisitAddressLines(path Path, node *AddressLines) error
+	}
+
+	// AddressLongitudeVisitor - Implemented by visitors passed to Visit that handle *AddressLongitude nodes.
+	AddressLongitudeVisitor interface {
+		VisitAddressLongitude(path Path, node *AddressLongitude) error
+	}
+
+	// AddressLongitudeDirectionVisitor - Implemented by visitors passed to Visit that handle *AddressLongitudeDirection nodes.
+	AddressLongitudeDirectionVisitor interface {
+		VisitAddressLongitudeDirection(path Path, node *AddressLongitudeDirection) error
+	}
+
+	// AdministrativeAreaVisitor - Implemented by visitors passed to Visit that handle *AdministrativeArea nodes.
+	AdministrativeAreaVisitor interface {
+		VisitAdministrativeArea(path Path, node *AdministrativeArea) error
+	}
+
+	// AdministrativeAreaNameVisitor - Implemented by visitors passed to Visit that handle *AdministrativeAreaName nodes.
+	AdministrativeAreaNameVisitor interface {
+		VisitAdministrativeAreaName(path Path, node *AdministrativeAreaName) error
+	}
+
+	// BarcodeVisitor - Implemented by visitors passed to Visit that handle *Barcode nodes.
+	BarcodeVisitor interface {
+		VisitBarcode(path Path, node *Barcode) error
+	}
+
+	// BuildingNameVisitor - Implemented by visitors passed to Visit that handle *BuildingName nodes.
+	BuildingNameVisitor interface {
+		VisitBuildingName(path Path, node *BuildingName) error
+	}
+
+	// CountryVisitor - Implemented by visitors passed to Visit that handle *Country nodes.
+	CountryVisitor interface {
+		VisitCountry(path Path, node *Country) error
+	}
+
+	// CountryNameVisitor - Implemented by visitors passed to Visit that handle *CountryName nodes.
+	CountryNameVisitor interface {
+		VisitCountryName(path Path, node *CountryName) error
+	}
+
+	// CountryNameCodeVisitor - Implemented by visitors passed to Visit that handle *CountryNameCode nodes.
+	CountryNameCodeVisitor interface {
+		VisitCountryNameCode(path Path, node *CountryNameCode) error
+	}
+
+	// DepartmentVisitor - Implemented by visitors passed to Visit that handle *Department nodes.
+	DepartmentVisitor interface {
+		VisitDepartment(path Path, node *Department) error
+	}
+
+	// DepartmentNameVisitor - Implemented by visitors passed to Visit that handle *DepartmentName nodes.
+	DepartmentNameVisitor interface {
+		VisitDepartmentName(path Path, node *DepartmentName) error
+	}
+
+	// DependentLocalityVisitor - Implemented by visitors passed to Visit that handle *DependentLocality nodes.
+	DependentLocalityVisitor interface {
+		VisitDependentLocality(path Path, node *DependentLocality) error
+	}
+
+	// DependentLocalityNameVisitor - Implemented by visitors passed to Visit that handle *DependentLocalityName nodes.
+	DependentLocalityNameVisitor interface {
+		VisitDependentLocalityName(path Path, node *DependentLocalityName) error
+	}
+
+	// DependentLocalityNumberVisitor - Implemented by visitors passed to Visit that handle *DependentLocalityNumber nodes.
+	DependentLocalityNumberVisitor interface {
+		VisitDependentLocalityNumber(path Path, node *DependentLocalityNumber) error
+	}
+
+	// DependentThoroughfareVisitor - Implemented by visitors passed to Visit that handle *DependentThoroughfare nodes.
+	DependentThoroughfareVisitor interface {
+		VisitDependentThoroughfare(path Path, node *DependentThoroughfare) error
+	}
+
+	// EndorsementLineCodeVisitor - Implemented by visitors passed to Visit that handle *EndorsementLineCode nodes.
+	EndorsementLineCodeVisitor interface {
+		VisitEndorsementLineCode(path Path, node *EndorsementLineCode) error
+	}
+
+	// FirmVisitor - Implemented by visitors passed to Visit that handle *Firm nodes.
+	FirmVisitor interface {
+		VisitFirm(path Path, node *Firm) error
+	}
+
+	// FirmNameVisitor - Implemented by visitors passed to Visit that handle *FirmName nodes.
+	FirmNameVisitor interface {
+		VisitFirmName(path Path, node *FirmName) error
+	}
+
+	// KeyLineCodeVisitor - Implemented by visitors passed to Visit that handle *KeyLineCode nodes.
+	KeyLineCodeVisitor interface {
+		VisitKeyLineCode(path Path, node *KeyLineCode) error
+	}
+
+	// LargeMailUserVisitor - Implemented by visitors passed to Visit that handle *LargeMailUser nodes.
+	LargeMailUserVisitor interface {
+		VisitLargeMailUser(path Path, node *LargeMailUser) error
+	}
+
+	// LargeMailUserIdentifierVisitor - Implemented by visitors passed to Visit that handle *LargeMailUserIdentifier nodes.
+	LargeMailUserIdentifierVisitor interface {
+		VisitLargeMailUserIdentifier(path Path, node *LargeMailUserIdentifier) error
+	}
+
+	// LargeMailUserNameVisitor - Implemented by visitors passed to Visit that handle *LargeMailUserName nodes.
+	LargeMailUserNameVisitor interface {
+		VisitLargeMailUserName(path Path, node *LargeMailUserName) error
+	}
+
+	// LocalityVisitor - Implemented by visitors passed to Visit that handle *Locality nodes.
+	LocalityVisitor interface {
+		VisitLocality(path Path, node *Locality) error
+	}
+
+	// LocalityNameVisitor - Implemented by visitors passed to Visit that handle *LocalityName nodes.
+	LocalityNameVisitor interface {
+		VisitLocalityName(path Path, node *LocalityName) error
+	}
+
+	// MailStopVisitor - Implemented by visitors passed to Visit that handle *MailStop nodes.
+	MailStopVisitor interface {
+		VisitMailStop(path Path, node *MailStop) error
+	}
+
+	// MailStopNameVisitor - Implemented by visitors passed to Visit that handle *MailStopName nodes.
+	MailStopNameVisitor interface {
+		VisitMailStopName(path Path, node *MailStopName) error
+	}
+
+	// MailStopNumberVisitor - Implemented by visitors passed to Visit that handle *MailStopNumber nodes.
+	MailStopNumberVisitor interface {
+		VisitMailStopNumber(path Path, node *MailStopNumber) error
+	}
+
+	// PostBoxVisitor - Implemented by visitors passed to Visit that handle *PostBox nodes.
+	PostBoxVisitor interface {
+		VisitPostBox(path Path, node *PostBox) error
+	}
+
+	// PostBoxNumberVisitor - Implemented by visitors passed to Visit that handle *PostBoxNumber nodes.
+	PostBoxNumberVisitor interface {
+		VisitPostBoxNumber(path Path, node *PostBoxNumber) error
+	}
+
+	// PostBoxNumberExtensionVisitor - Implemented by visitors passed to Visit that handle *PostBoxNumberExtension nodes.
+	PostBoxNumberExtensionVisitor interface {
+		VisitPostBoxNumberExtension(path Path, node *PostBoxNumberExtension) error
+	}
+
+	// PostBoxNumberPrefixVisitor - Implemented by visitors passed to Visit that handle *PostBoxNumberPrefix nodes.
+	PostBoxNumberPrefixVisitor interface {
+		VisitPostBoxNumberPrefix(path Path, node *PostBoxNumberPrefix) error
+	}
+
+	// PostBoxNumberSuffixVisitor - Implemented by visitors passed to Visit that handle *PostBoxNumberSuffix nodes.
+	PostBoxNumberSuffixVisitor interface {
+		VisitPostBoxNumberSuffix(path Path, node *PostBoxNumberSuffix) error
+	}
+
+	// PostOfficeVisitor - Implemented by visitors passed to Visit that handle *PostOffice nodes.
+	PostOfficeVisitor interface {
+		VisitPostOffice(path Path, node *PostOffice) error
+	}
+
+	// PostOfficeNameVisitor - Implemented by visitors passed to Visit that handle *PostOfficeName nodes.
+	PostOfficeNameVisitor interface {
+		VisitPostOfficeName(path Path, node *PostOfficeName) error
+	}
+
+	// PostOfficeNumberVisitor - Implemented by visitors passed to Visit that handle *PostOfficeNumber nodes.
+	PostOfficeNumberVisitor interface {
+		VisitPostOfficeNumber(path Path, node *PostOfficeNumber) error
+	}
+
+	// PostTownVisitor - Implemented by visitors passed to Visit that handle *PostTown nodes.
+	PostTownVisitor interface {
+		VisitPostTown(path Path, node *PostTown) error
+	}
+
+	// PostTownNameVisitor - Implemented by visitors passed to Visit that handle *PostTownName nodes.
+	PostTownNameVisitor interface {
+		VisitPostTownName(path Path, node *PostTownName) error
+	}
+
+	// PostTownSuffixVisitor - Implemented by visitors passed to Visit that handle *PostTownSuffix nodes.
+	PostTownSuffixVisitor interface {
+		VisitPostTownSuffix(path Path, node *PostTownSuffix) error
+	}
+
+	// PostalCodeVisitor - Implemented by visitors passed to Visit that handle *PostalCode nodes.
+	PostalCodeVisitor interface {
+		VisitPostalCode(path Path, node *PostalCode) error
+	}
+
+	// PostalCodeNumberVisitor - Implemented by visitors passed to Visit that handle *PostalCodeNumber nodes.
+	PostalCodeNumberVisitor interface {
+		VisitPostalCodeNumber(path Path, node *PostalCodeNumber) error
+	}
+
+	// PostalCodeNumberExtensionVisitor - Implemented by visitors passed to Visit that handle *PostalCodeNumberExtension nodes.
+	PostalCodeNumberExtensionVisitor interface {
+		VisitPostalCodeNumberExtension(path Path, node *PostalCodeNumberExtension) error
+	}
+
+	// PostalRouteVisitor - Implemented by visitors passed to Visit that handle *PostalRoute nodes.
+	PostalRouteVisitor interface {
+		VisitPostalRoute(path Path, node *PostalRoute) error
+	}
+
+	// PostalRouteNameVisitor - Implemented by visitors passed to Visit that handle *PostalRouteName nodes.
+	PostalRouteNameVisitor interface {
+		VisitPostalRouteName(path Path, node *PostalRouteName) error
+	}
+
+	// PostalRouteNumberVisitor - Implemented by visitors passed to Visit that handle *PostalRouteNumber nodes.
+	PostalRouteNumberVisitor interface {
+		VisitPostalRouteNumber(path Path, node *PostalRouteNumber) error
+	}
+
+	// PostalServiceElementsVisitor - Implemented by visitors passed to Visit that handle *PostalServiceElements nodes.
+	PostalServiceElementsVisitor interface {
+		VisitPostalServiceElements(path Path, node *PostalServiceElements) error
+	}
+
+	// PremiseVisitor - Implemented by visitors passed to Visit that handle *Premise nodes.
+	PremiseVisitor interface {
+		VisitPremise(path Path, node *Premise) error
+	}
+
+	// PremiseLocationVisitor - Implemented by visitors passed to Visit that handle *PremiseLocation nodes.
+	PremiseLocationVisitor interface {
+		VisitPremiseLocation(path Path, node *PremiseLocation) error
+	}
+
+	// PremiseNameVisitor - Implemented by visitors passed to Visit that handle *PremiseName nodes.
+	PremiseNameVisitor interface {
+		VisitPremiseName(path Path, node *PremiseName) error
+	}
+
+	// PremiseNumberVisitor - Implemented by visitors passed to Visit that handle *PremiseNumber nodes.
+	PremiseNumberVisitor interface {
+		VisitPremiseNumber(path Path, node *PremiseNumber) error
+	}
+
+	// PremiseNumberPrefixVisitor - Implemented by visitors passed to Visit that handle *PremiseNumberPrefix nodes.
+	PremiseNumberPrefixVisitor interface {
+		VisitPremiseNumberPrefix(path Path, node *PremiseNumberPrefix) error
+	}
+
+	// PremiseNumberRangeVisitor - Implemented by visitors passed to Visit that handle *PremiseNumberRange nodes.
+	PremiseNumberRangeVisitor interface {
+		VisitPremiseNumberRange(path Path, node *PremiseNumberRange) error
+	}
+
+	// PremiseNumberRangeFromVisitor - Implemented by visitors passed to Visit that handle *PremiseNumberRangeFrom nodes.
+	PremiseNumberRangeFromVisitor interface {
+		VisitPremiseNumberRangeFrom(path Path, node *PremiseNumberRangeFrom) error
+	}
+
+	// PremiseNumberRangeToVisitor - Implemented by visitors passed to Visit that handle *PremiseNumberRangeTo nodes.
+	PremiseNumberRangeToVisitor interface {
+		VisitPremiseNumberRangeTo(path Path, node *PremiseNumberRangeTo) error
+	}
+
+	// PremiseNumberSuffixVisitor - Implemented by visitors passed to Visit that handle *PremiseNumberSuffix nodes.
+	PremiseNumberSuffixVisitor interface {
+		VisitPremiseNumberSuffix(path Path, node *PremiseNumberSuffix) error
+	}
+
+	// SortingCodeVisitor - Implemented by visitors passed to Visit that handle *SortingCode nodes.
+	SortingCodeVisitor interface {
+		VisitSortingCode(path Path, node *SortingCode) error
+	}
+
+	// SubAdministrativeAreaVisitor - Implemented by visitors passed to Visit that handle *SubAdministrativeArea nodes.
+	SubAdministrativeAreaVisitor interface {
+		VisitSubAdministrativeArea(path Path, node *SubAdministrativeArea) error
+	}
+
+	// SubAdministrativeAreaNameVisitor - Implemented by visitors passed to Visit that handle *SubAdministrativeAreaName nodes.
+	SubAdministrativeAreaNameVisitor interface {
+		VisitSubAdministrativeAreaName(path Path, node *SubAdministrativeAreaName) error
+	}
+
+	// SubPremiseVisitor - Implemented by visitors passed to Visit that handle *SubPremise nodes.
+	SubPremiseVisitor interface {
+		VisitSubPremise(path Path, node *SubPremise) error
+	}
+
+	// SubPremiseLocationVisitor - Implemented by visitors passed to Visit that handle *SubPremiseLocation nodes.
+	SubPremiseLocationVisitor interface {
+		VisitSubPremiseLocation(path Path, node *SubPremiseLocation) error
+	}
+
+	// SubPremiseNameVisitor - Implemented by visitors passed to Visit that handle *SubPremiseName nodes.
+	SubPremiseNameVisitor interface {
+		VisitSubPremiseName(path Path, node *SubPremiseName) error
+	}
+
+	// SubPremiseNumberVisitor - Implemented by visitors passed to Visit that handle *SubPremiseNumber nodes.
+	SubPremiseNumberVisitor interface {
+		VisitSubPremiseNumber(path Path, node *SubPremiseNumber) error
+	}
+
+	// SubPremiseNumberPrefixVisitor - Implemented by visitors passed to Visit that handle *SubPremiseNumberPrefix nodes.
+	SubPremiseNumberPrefixVisitor interface {
+		VisitSubPremiseNumberPrefix(path Path, node *SubPremiseNumberPrefix) error
+	}
+
+	// SubPremiseNumberSuffixVisitor - Implemented by visitors passed to Visit that handle *SubPremiseNumberSuffix nodes.
+	SubPremiseNumberSuffixVisitor interface {
+		VisitSubPremiseNumberSuffix(path Path, node *SubPremiseNumberSuffix) error
+	}
+
+	// SupplementaryPostalServiceDataVisitor - Implemented by visitors passed to Visit that handle *SupplementaryPostalServiceData nodes.
+	SupplementaryPostalServiceDataVisitor interface {
+		VisitSupplementaryPostalServiceData(path Path, node *SupplementaryPostalServiceData) error
+	}
+
+	// ThoroughfareVisitor - Implemented by visitors passed to Visit that handle *Thoroughfare nodes.
+	ThoroughfareVisitor interface {
+		VisitThoroughfare(path Path, node *Thoroughfare) error
+	}
+
+	// ThoroughfareLeadingTypeVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareLeadingType nodes.
+	ThoroughfareLeadingTypeVisitor interface {
+		VisitThoroughfareLeadingType(path Path, node *ThoroughfareLeadingType) error
+	}
+
+	// ThoroughfareNameVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareName nodes.
+	ThoroughfareNameVisitor interface {
+		VisitThoroughfareName(path Path, node *ThoroughfareName) error
+	}
+
+	// ThoroughfareNumberVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareNumber nodes.
+	ThoroughfareNumberVisitor interface {
+		VisitThoroughfareNumber(path Path, node *ThoroughfareNumber) error
+	}
+
+	// ThoroughfareNumberFromVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareNumberFrom nodes.
+	ThoroughfareNumberFromVisitor interface {
+		VisitThoroughfareNumberFrom(path Path, node *ThoroughfareNumberFrom) error
+	}
+
+	// ThoroughfareNumberPrefixVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareNumberPrefix nodes.
+	ThoroughfareNumberPrefixVisitor interface {
+		VisitThoroughfareNumberPrefix(path Path, node *ThoroughfareNumberPrefix) error
+	}
+
+	// ThoroughfareNumberRangeVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareNumberRange nodes.
+	ThoroughfareNumberRangeVisitor interface {
+		VisitThoroughfareNumberRange(path Path, node *ThoroughfareNumberRange) error
+	}
+
+	// ThoroughfareNumberSuffixVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareNumberSuffix nodes.
+	ThoroughfareNumberSuffixVisitor interface {
+		VisitThoroughfareNumberSuffix(path Path, node *ThoroughfareNumberSuffix) error
+	}
+
+	// ThoroughfareNumberToVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareNumberTo nodes.
+	ThoroughfareNumberToVisitor interface {
+		VisitThoroughfareNumberTo(path Path, node *ThoroughfareNumberTo) error
+	}
+
+	// ThoroughfarePostDirectionVisitor - Implemented by visitors passed to Visit that handle *ThoroughfarePostDirection nodes.
+	ThoroughfarePostDirectionVisitor interface {
+		VisitThoroughfarePostDirection(path Path, node *ThoroughfarePostDirection) error
+	}
+
+	// ThoroughfarePreDirectionVisitor - Implemented by visitors passed to Visit that handle *ThoroughfarePreDirection nodes.
+	ThoroughfarePreDirectionVisitor interface {
+		VisitThoroughfarePreDirection(path Path, node *ThoroughfarePreDirection) error
+	}
+
+	// ThoroughfareTrailingTypeVisitor - Implemented by visitors passed to Visit that handle *ThoroughfareTrailingType nodes.
+	ThoroughfareTrailingTypeVisitor interface {
+		VisitThoroughfareTrailingType(path Path, node *ThoroughfareTrailingType) error
+	}
+
+	// XNLVisitor - Implemented by visitors passed to Visit that handle *XNL nodes.
+	XNLVisitor interface {
+		VisitXNL(path Path, node *XNL) error
+	}
+
+	// AddresseeIndicatorVisitor - Implemented by visitors passed to Visit that handle *AddresseeIndicator nodes.
+	AddresseeIndicatorVisitor interface {
+		VisitAddresseeIndicator(path Path, node *AddresseeIndicator) error
+	}
+
+	// AliasVisitor - Implemented by visitors passed to Visit that handle *Alias nodes.
+	AliasVisitor interface {
+		VisitAlias(path Path, node *Alias) error
+	}
+
+	// DependencyNameVisitor - Implemented by visitors passed to Visit that handle *DependencyName nodes.
+	DependencyNameVisitor interface {
+		VisitDependencyName(path Path, node *DependencyName) error
+	}
+
+	// FirstNameVisitor - Implemented by visitors passed to Visit that handle *FirstName nodes.
+	FirstNameVisitor interface {
+		VisitFirstName(path Path, node *FirstName) error
+	}
+
+	// FormerNameVisitor - Implemented by visitors passed to Visit that handle *FormerName nodes.
+	FormerNameVisitor interface {
+		VisitFormerName(path Path, node *FormerName) error
+	}
+
+	// FunctionVisitor - Implemented by visitors passed to Visit that handle *Function nodes.
+	FunctionVisitor interface {
+		VisitFunction(path Path, node *Function) error
+	}
+
+	// GeneralSuffixVisitor - Implemented by visitors passed to Visit that handle *GeneralSuffix nodes.
+	GeneralSuffixVisitor interface {
+		VisitGeneralSuffix(path Path, node *GeneralSuffix) error
+	}
+
+	// GenerationIdentifierVisitor - Implemented by visitors passed to Visit that handle *GenerationIdentifier nodes.
+	GenerationIdentifierVisitor interface {
+		VisitGenerationIdentifier(path Path, node *GenerationIdentifier) error
+	}
+
+	// JointPersonNameVisitor - Implemented by visitors passed to Visit that handle *JointPersonName nodes.
+	JointPersonNameVisitor interface {
+		VisitJointPersonName(path Path, node *JointPersonName) error
+	}
+
+	// KnownAsVisitor - Implemented by visitors passed to Visit that handle *KnownAs nodes.
+	KnownAsVisitor interface {
+		VisitKnownAs(path Path, node *KnownAs) error
+	}
+
+	// LastNameVisitor - Implemented by visitors passed to Visit that handle *LastName nodes.
+	LastNameVisitor interface {
+		VisitLastName(path Path, node *LastName) error
+	}
+
+	// MiddleNameVisitor - Implemented by visitors passed to Visit that handle *MiddleName nodes.
+	MiddleNameVisitor interface {
+		VisitMiddleName(path Path, node *MiddleName) error
+	}
+
+	// NameDetailsVisitor - Implemented by visitors passed to Visit that handle *NameDetails nodes.
+	NameDetailsVisitor interface {
+		VisitNameDetails(path Path, node *NameDetails) error
+	}
+
+	// NameLineVisitor - Implemented by visitors passed to Visit that handle *NameLine nodes.
+	NameLineVisitor interface {
+		VisitNameLine(path Path, node *NameLine) error
+	}
+
+	// NamePrefixVisitor - Implemented by visitors passed to Visit that handle *NamePrefix nodes.
+	NamePrefixVisitor interface {
+		VisitNamePrefix(path Path, node *NamePrefix) error
+	}
+
+	// OrganisationFormerNameVisitor - Implemented by visitors passed to Visit that handle *OrganisationFormerName nodes.
+	OrganisationFormerNameVisitor interface {
+		VisitOrganisationFormerName(path Path, node *OrganisationFormerName) error
+	}
+
+	// OrganisationKnownAsVisitor - Implemented by visitors passed to Visit that handle *OrganisationKnownAs nodes.
+	OrganisationKnownAsVisitor interface {
+		VisitOrganisationKnownAs(path Path, node *OrganisationKnownAs) error
+	}
+
+	// OrganisationNameVisitor - Implemented by visitors passed to Visit that handle *OrganisationName nodes.
+	OrganisationNameVisitor interface {
+		VisitOrganisationName(path Path, node *OrganisationName) error
+	}
+
+	// OrganisationNameDetailsVisitor - Implemented by visitors passed to Visit that handle *OrganisationNameDetails nodes.
+	OrganisationNameDetailsVisitor interface {
+		VisitOrganisationNameDetails(path Path, node *OrganisationNameDetails) error
+	}
+
+	// OrganisationTypeVisitor - Implemented by visitors passed to Visit that handle *OrganisationType nodes.
+	OrganisationTypeVisitor interface {
+		VisitOrganisationType(path Path, node *OrganisationType) error
+	}
+
+	// OtherNameVisitor - Implemented by visitors passed to Visit that handle *OtherName nodes.
+	OtherNameVisitor interface {
+		VisitOtherName(path Path, node *OtherName) error
+	}
+
+	// PersonNameVisitor - Implemented by visitors passed to Visit that handle *PersonName nodes.
+	PersonNameVisitor interface {
+		VisitPersonName(path Path, node *PersonName) error
+	}
+
+	// PrecedingTitleVisitor - Implemented by visitors passed to Visit that handle *PrecedingTitle nodes.
+	PrecedingTitleVisitor interface {
+		VisitPrecedingTitle(path Path, node *PrecedingTitle) error
+	}
+
+	// SuffixVisitor - Implemented by visitors passed to Visit that handle *Suffix nodes.
+	SuffixVisitor interface {
+		VisitSuffix(path Path, node *Suffix) error
+	}
+
+	// TitleVisitor - Implemented by visitors passed to Visit that handle *Title nodes.
+	TitleVisitor interface {
+		VisitTitle(path Path, node *Title) error
+	}
+)
+
+// isNode reports whether node is a pointer to one of the xAL or xNL types.
+func isNode(node any) bool {
+	switch node.(type) {
+	case *XAL, *Address, *AddressDetails, *AddressIdentifier, *AddressLatitude, *AddressLatitudeDirection, *AddressLine, *AddressLines, *AddressLongitude, *AddressLongitudeDirection, *AdministrativeArea, *AdministrativeAreaName, *Barcode, *BuildingName, *Country, *CountryName, *CountryNameCode, *Department, *DepartmentName, *DependentLocality, *DependentLocalityName, *DependentLocalityNumber, *DependentThoroughfare, *EndorsementLineCode, *Firm, *FirmName, *KeyLineCode, *LargeMailUser, *LargeMailUserIdentifier, *LargeMailUserName, *Locality, *LocalityName, *MailStop, *MailStopName, *MailStopNumber, *PostBox, *PostBoxNumber, *PostBoxNumberExtension, *PostBoxNumberPrefix, *PostBoxNumberSuffix, *PostOffice, *PostOfficeName, *PostOfficeNumber, *PostTown, *PostTownName, *PostTownSuffix, *PostalCode, *PostalCodeNumber, *PostalCodeNumberExtension, *PostalRoute, *PostalRouteName, *PostalRouteNumber, *PostalServiceElements, *Premise, *PremiseLocation, *PremiseName, *PremiseNumber, *PremiseNumberPrefix, *PremiseNumberRange, *PremiseNumberRangeFrom, *PremiseNumberRangeTo, *PremiseNumberSuffix, *SortingCode, *SubAdministrativeArea, *SubAdministrativeAreaName, *SubPremise, *SubPremiseLocation, *SubPremiseName, *SubPremiseNumber, *SubPremiseNumberPrefix, *SubPremiseNumberSuffix, *SupplementaryPostalServiceData, *Thoroughfare, *ThoroughfareLeadingType, *ThoroughfareName, *ThoroughfareNumber, *ThoroughfareNumberFrom, *ThoroughfareNumberPrefix, *ThoroughfareNumberRange, *ThoroughfareNumberSuffix, *ThoroughfareNumberTo, *ThoroughfarePostDirection, *ThoroughfarePreDirection, *ThoroughfareTrailingType, *XNL, *AddresseeIndicator, *Alias, *DependencyName, *FirstName, *FormerName, *Function, *GeneralSuffix, *GenerationIdentifier, *JointPersonName, *KnownAs, *LastName, *MiddleName, *NameDetails, *NameLine, *NamePrefix, *OrganisationFormerName, *OrganisationKnownAs, *OrganisationName, *OrganisationNameDetails, *OrganisationType, *OtherName, *PersonName, *PrecedingTitle, *Suffix, *Title:
+		return true
+	}
+	return false
+}
+
+// visit calls the method of the typed visitor interface v implements for node, if any.
+func visit(v any, path Path, node any) error {
+	switch n := node.(type) {
+	case *XAL:
+		if v, ok := v.(XALVisitor); ok {
+			return v.VisitXAL(path, n)
+		}
+	case *Address:
+		if v, ok := v.(AddressVisitor); ok {
+			return v.VisitAddress(path, n)
+		}
+	case *AddressDetails:
+		if v, ok := v.(AddressDetailsVisitor); ok {
+			return v.VisitAddressDetails(path, n)
+		}
+	case *AddressIdentifier:
+		if v, ok := v.(AddressIdentifierVisitor); ok {
+			return v.VisitAddressIdentifier(path, n)
+		}
+	case *AddressLatitude:
+		if v, ok := v.(AddressLatitudeVisitor); ok {
+			return v.VisitAddressLatitude(path, n)
+		}
+	case *AddressLatitudeDirection:
+		if v, ok := v.(AddressLatitudeDirectionVisitor); ok {
+			return v.VisitAddressLatitudeDirection(path, n)
+		}
+	case *AddressLine:
+		if v, ok := v.(AddressLineVisitor); ok {
+			return v.VisitAddressLine(path, n)
+		}
+	case *AddressLines:
+		if v, ok := v.(AddressLinesVisitor); ok {
+			return v.VisitAddressLines(path, n)
+		}
+	case *AddressLongitude:
+		if v, ok := v.(AddressLongitudeVisitor); ok {
+			return v.VisitAddressLongitude(path, n)
+		}
+	case *AddressLongitudeDirection:
+		if v, ok := v.(AddressLongitudeDirectionVisitor); ok {
+			return v.VisitAddressLongitudeDirection(path, n)
+		}
+	case *AdministrativeArea:
+		if v, ok := v.(AdministrativeAreaVisitor); ok {
+			return v.VisitAdministrativeArea(path, n)
+		}
+	case *AdministrativeAreaName:
+		if v, ok := v.(AdministrativeAreaNameVisitor); ok {
+			return v.VisitAdministrativeAreaName(path, n)
+		}
+	case *Barcode:
+		if v, ok := v.(BarcodeVisitor); ok {
+			return v.VisitBarcode(path, n)
+		}
+	case *BuildingName:
+		if v, ok := v.(BuildingNameVisitor); ok {
+			return v.VisitBuildingName(path, n)
+		}
+	case *Country:
+		if v, ok := v.(CountryVisitor); ok {
+			return v.VisitCountry(path, n)
+		}
+	case *CountryName:
+		if v, ok := v.(CountryNameVisitor); ok {
+			return v.VisitCountryName(path, n)
+		}
+	case *CountryNameCode:
+		if v, ok := v.(CountryNameCodeVisitor); ok {
+			return v.VisitCountryNameCode(path, n)
+		}
+	case *Department:
+		if v, ok := v.(DepartmentVisitor); ok {
+			return v.VisitDepartment(path, n)
+		}
+	case *DepartmentName:
+		if v, ok := v.(DepartmentNameVisitor); ok {
+			return v.VisitDepartmentName(path, n)
+		}
+	case *DependentLocality:
+		if v, ok := v.(DependentLocalityVisitor); ok {
+			return v.VisitDependentLocality(path, n)
+		}
+	case *DependentLocalityName:
+		if v, ok := v.(DependentLocalityNameVisitor); ok {
+			return v.VisitDependentLocalityName(path, n)
+		}
+	case *DependentLocalityNumber:
+		if v, ok := v.(DependentLocalityNumberVisitor); ok {
+			return v.VisitDependentLocalityNumber(path, n)
+		}
+	case *DependentThoroughfare:
+		if v, ok := v.(DependentThoroughfareVisitor); ok {
+			return v.VisitDependentThoroughfare(path, n)
+		}
+	case *EndorsementLineCode:
+		if v, ok := v.(EndorsementLineCodeVisitor); ok {
+			return v.VisitEndorsementLineCode(path, n)
+		}
+	case *Firm:
+		if v, ok := v.(FirmVisitor); ok {
+			return v.VisitFirm(path, n)
+		}
+	case *FirmName:
+		if v, ok := v.(FirmNameVisitor); ok {
+			return v.VisitFirmName(path, n)
+		}
+	case *KeyLineCode:
+		if v, ok := v.(KeyLineCodeVisitor); ok {
+			return v.VisitKeyLineCode(path, n)
+		}
+	case *LargeMailUser:
+		if v, ok := v.(LargeMailUserVisitor); ok {
+			return v.VisitLargeMailUser(path, n)
+		}
+	case *LargeMailUserIdentifier:
+		if v, ok := v.(LargeMailUserIdentifierVisitor); ok {
+			return v.VisitLargeMailUserIdentifier(path, n)
+		}
+	case *LargeMailUserName:
+		if v, ok := v.(LargeMailUserNameVisitor); ok {
+			return v.VisitLargeMailUserName(path, n)
+		}
+	case *Locality:
+		if v, ok := v.(LocalityVisitor); ok {
+			return v.VisitLocality(path, n)
+		}
+	case *LocalityName:
+		if v, ok := v.(LocalityNameVisitor); ok {
+			return v.VisitLocalityName(path, n)
+		}
+	case *MailStop:
+		if v, ok := v.(MailStopVisitor); ok {
+			return v.VisitMailStop(path, n)
+		}
+	case *MailStopName:
+		if v, ok := v.(MailStopNameVisitor); ok {
+			return v.VisitMailStopName(path, n)
+		}
+	case *MailStopNumber:
+		if v, ok := v.(MailStopNumberVisitor); ok {
+			return v.VisitMailStopNumber(path, n)
+		}
+	case *PostBox:
+		if v, ok := v.(PostBoxVisitor); ok {
+			return v.VisitPostBox(path, n)
+		}
+	case *PostBoxNumber:
+		if v, ok := v.(PostBoxNumberVisitor); ok {
+			return v.VisitPostBoxNumber(path, n)
+		}
+	case *PostBoxNumberExtension:
+		if v, ok := v.(PostBoxNumberExtensionVisitor); ok {
+			return v.VisitPostBoxNumberExtension(path, n)
+		}
+	case *PostBoxNumberPrefix:
+		if v, ok := v.(PostBoxNumberPrefixVisitor); ok {
+			return v.VisitPostBoxNumberPrefix(path, n)
+		}
+	case *PostBoxNumberSuffix:
+		if v, ok := v.(PostBoxNumberSuffixVisitor); ok {
+			return v.VisitPostBoxNumberSuffix(path, n)
+		}
+	case *PostOffice:
+		if v, ok := v.(PostOfficeVisitor); ok {
+			return v.VisitPostOffice(path, n)
+		}
+	case *PostOfficeName:
+		if v, ok := v.(PostOfficeNameVisitor); ok {
+			return v.VisitPostOfficeName(path, n)
+		}
+	case *PostOfficeNumber:
+		if v, ok := v.(PostOfficeNumberVisitor); ok {
+			return v.VisitPostOfficeNumber(path, n)
+		}
+	case *PostTown:
+		if v, ok := v.(PostTownVisitor); ok {
+			return v.VisitPostTown(path, n)
+		}
+	case *PostTownName:
+		if v, ok := v.(PostTownNameVisitor); ok {
+			return v.VisitPostTownName(path, n)
+		}
+	case *PostTownSuffix:
+		if v, ok := v.(PostTownSuffixVisitor); ok {
+			return v.VisitPostTownSuffix(path, n)
+		}
+	case *PostalCode:
+		if v, ok := v.(PostalCodeVisitor); ok {
+			return v.VisitPostalCode(path, n)
+		}
+	case *PostalCodeNumber:
+		if v, ok := v.(PostalCodeNumberVisitor); ok {
+			return v.VisitPostalCodeNumber(path, n)
+		}
+	case *PostalCodeNumberExtension:
+		if v, ok := v.(PostalCodeNumberExtensionVisitor); ok {
+			return v.VisitPostalCodeNumberExtension(path, n)
+		}
+	case *PostalRoute:
+		if v, ok := v.(PostalRouteVisitor); ok {
+			return v.VisitPostalRoute(path, n)
+		}
+	case *PostalRouteName:
+		if v, ok := v.(PostalRouteNameVisitor); ok {
+			return v.VisitPostalRouteName(path, n)
+		}
+	case *PostalRouteNumber:
+		if v, ok := v.(PostalRouteNumberVisitor); ok {
+			return v.VisitPostalRouteNumber(path, n)
+		}
+	case *PostalServiceElements:
+		if v, ok := v.(PostalServiceElementsVisitor); ok {
+			return v.VisitPostalServiceElements(path, n)
+		}
+	case *Premise:
+		if v, ok := v.(PremiseVisitor); ok {
+			return v.VisitPremise(path, n)
+		}
+	case *PremiseLocation:
+		if v, ok := v.(PremiseLocationVisitor); ok {
+			return v.VisitPremiseLocation(path, n)
+		}
+	case *PremiseName:
+		if v, ok := v.(PremiseNameVisitor); ok {
+			return v.VisitPremiseName(path, n)
+		}
+	case *PremiseNumber:
+		if v, ok := v.(PremiseNumberVisitor); ok {
+			return v.VisitPremiseNumber(path, n)
+		}
+	case *PremiseNumberPrefix:
+		if v, ok := v.(PremiseNumberPrefixVisitor); ok {
+			return v.VisitPremiseNumberPrefix(path, n)
+		}
+	case *PremiseNumberRange:
+		if v, ok := v.(PremiseNumberRangeVisitor); ok {
+			return v.VisitPremiseNumberRange(path, n)
+		}
+	case *PremiseNumberRangeFrom:
+		if v, ok := v.(PremiseNumberRangeFromVisitor); ok {
+			return v.VisitPremiseNumberRangeFrom(path, n)
+		}
+	case *PremiseNumberRangeTo:
+		if v, ok := v.(PremiseNumberRangeToVisitor); ok {
+			return v.VisitPremiseNumberRangeTo(path, n)
+		}
+	case *PremiseNumberSuffix:
+		if v, ok := v.(PremiseNumberSuffixVisitor); ok {
+			return v.VisitPremiseNumberSuffix(path, n)
+		}
+	case *SortingCode:
+		if v, ok := v.(SortingCodeVisitor); ok {
+			return v.VisitSortingCode(path, n)
+		}
+	case *SubAdministrativeArea:
+		if v, ok := v.(SubAdministrativeAreaVisitor); ok {
+			return v.VisitSubAdministrativeArea(path, n)
+		}
+	case *SubAdministrativeAreaName:
+		if v, ok := v.(SubAdministrativeAreaNameVisitor); ok {
+			return v.VisitSubAdministrativeAreaName(path, n)
+		}
+	case *SubPremise:
+		if v, ok := v.(SubPremiseVisitor); ok {
+			return v.VisitSubPremise(path, n)
+		}
+	case *SubPremiseLocation:
+		if v, ok := v.(SubPremiseLocationVisitor); ok {
+			return v.VisitSubPremiseLocation(path, n)
+		}
+	case *SubPremiseName:
+		if v, ok := v.(SubPremiseNameVisitor); ok {
+			return v.VisitSubPremiseName(path, n)
+		}
+	case *SubPremiseNumber:
+		if v, ok := v.(SubPremiseNumberVisitor); ok {
+			return v.VisitSubPremiseNumber(path, n)
+		}
+	case *SubPremiseNumberPrefix:
+		if v, ok := v.(SubPremiseNumberPrefixVisitor); ok {
+			return v.VisitSubPremiseNumberPrefix(path, n)
+		}
+	case *SubPremiseNumberSuffix:
+		if v, ok := v.(SubPremiseNumberSuffixVisitor); ok {
+			return v.VisitSubPremiseNumberSuffix(path, n)
+		}
+	case *SupplementaryPostalServiceData:
+		if v, ok := v.(SupplementaryPostalServiceDataVisitor); ok {
+			return v.VisitSupplementaryPostalServiceData(path, n)
+		}
+	case *Thoroughfare:
+		if v, ok := v.(ThoroughfareVisitor); ok {
+			return v.VisitThoroughfare(path, n)
+		}
+	case *ThoroughfareLeadingType:
+		if v, ok := v.(ThoroughfareLeadingTypeVisitor); ok {
+			return v.VisitThoroughfareLeadingType(path, n)
+		}
+	case *ThoroughfareName:
+		if v, ok := v.(ThoroughfareNameVisitor); ok {
+			return v.VisitThoroughfareName(path, n)
+		}
+	case *ThoroughfareNumber:
+		if v, ok := v.(ThoroughfareNumberVisitor); ok {
+			return v.VisitThoroughfareNumber(path, n)
+		}
+	case *ThoroughfareNumberFrom:
+		if v, ok := v.(ThoroughfareNumberFromVisitor); ok {
+			return v.VisitThoroughfareNumberFrom(path, n)
+		}
+	case *ThoroughfareNumberPrefix:
+		if v, ok := v.(ThoroughfareNumberPrefixVisitor); ok {
+			return v.VisitThoroughfareNumberPrefix(path, n)
+		}
+	case *ThoroughfareNumberRange:
+		if v, ok := v.(ThoroughfareNumberRangeVisitor); ok {
+			return v.VisitThoroughfareNumberRange(path, n)
+		}
+	case *ThoroughfareNumberSuffix:
+		if v, ok := v.(ThoroughfareNumberSuffixVisitor); ok {
+			return v.VisitThoroughfareNumberSuffix(path, n)
+		}
+	case *ThoroughfareNumberTo:
+		if v, ok := v.(ThoroughfareNumberToVisitor); ok {
+			return v.VisitThoroughfareNumberTo(path, n)
+		}
+	case *ThoroughfarePostDirection:
+		if v, ok := v.(ThoroughfarePostDirectionVisitor); ok {
+			return v.VisitThoroughfarePostDirection(path, n)
+		}
+	case *ThoroughfarePreDirection:
+		if v, ok := v.(ThoroughfarePreDirectionVisitor); ok {
+			return v.VisitThoroughfarePreDirection(path, n)
+		}
+	case *ThoroughfareTrailingType:
+		if v, ok := v.(ThoroughfareTrailingTypeVisitor); ok {
+			return v.VisitThoroughfareTrailingType(path, n)
+		}
+	case *XNL:
+		if v, ok := v.(XNLVisitor); ok {
+			return v.VisitXNL(path, n)
+		}
+	case *AddresseeIndicator:
+		if v, ok := v.(AddresseeIndicatorVisitor); ok {
+			return v.VisitAddresseeIndicator(path, n)
+		}
+	case *Alias:
+		if v, ok := v.(AliasVisitor); ok {
+			return v.VisitAlias(path, n)
+		}
+	case *DependencyName:
+		if v, ok := v.(DependencyNameVisitor); ok {
+			return v.VisitDependencyName(path, n)
+		}
+	case *FirstName:
+		if v, ok := v.(FirstNameVisitor); ok {
+			return v.VisitFirstName(path, n)
+		}
+	case *FormerName:
+		if v, ok := v.(FormerNameVisitor); ok {
+			return v.VisitFormerName(path, n)
+		}
+	case *Function:
+		if v, ok := v.(FunctionVisitor); ok {
+			return v.VisitFunction(path, n)
+		}
+	case *GeneralSuffix:
+		if v, ok := v.(GeneralSuffixVisitor); ok {
+			return v.VisitGeneralSuffix(path, n)
+		}
+	case *GenerationIdentifier:
+		if v, ok := v.(GenerationIdentifierVisitor); ok {
+			return v.VisitGenerationIdentifier(path, n)
+		}
+	case *JointPersonName:
+		if v, ok := v.(JointPersonNameVisitor); ok {
+			return v.VisitJointPersonName(path, n)
+		}
+	case *KnownAs:
+		if v, ok := v.(KnownAsVisitor); ok {
+			return v.VisitKnownAs(path, n)
+		}
+	case *LastName:
+		if v, ok := v.(LastNameVisitor); ok {
+			return v.VisitLastName(path, n)
+		}
+	case *MiddleName:
+		if v, ok := v.(MiddleNameVisitor); ok {
+			return v.VisitMiddleName(path, n)
+		}
+	case *NameDetails:
+		if v, ok := v.(NameDetailsVisitor); ok {
+			return v.VisitNameDetails(path, n)
+		}
+	case *NameLine:
+		if v, ok := v.(NameLineVisitor); ok {
+			return v.VisitNameLine(path, n)
+		}
+	case *NamePrefix:
+		if v, ok := v.(NamePrefixVisitor); ok {
+			return v.VisitNamePrefix(path, n)
+		}
+	case *OrganisationFormerName:
+		if v, ok := v.(OrganisationFormerNameVisitor); ok {
+			return v.VisitOrganisationFormerName(path, n)
+		}
+	case *OrganisationKnownAs:
+		if v, ok := v.(OrganisationKnownAsVisitor); ok {
+			return v.VisitOrganisationKnownAs(path, n)
+		}
+	case *OrganisationName:
+		if v, ok := v.(OrganisationNameVisitor); ok {
+			return v.VisitOrganisationName(path, n)
+		}
+	case *OrganisationNameDetails:
+		if v, ok := v.(OrganisationNameDetailsVisitor); ok {
+			return v.VisitOrganisationNameDetails(path, n)
+		}
+	case *OrganisationType:
+		if v, ok := v.(OrganisationTypeVisitor); ok {
+			return v.VisitOrganisationType(path, n)
+		}
+	case *OtherName:
+		if v, ok := v.(OtherNameVisitor); ok {
+			return v.VisitOtherName(path, n)
+		}
+	case *PersonName:
+		if v, ok := v.(PersonNameVisitor); ok {
+			return v.VisitPersonName(path, n)
+		}
+	case *PrecedingTitle:
+		if v, ok := v.(PrecedingTitleVisitor); ok {
+			return v.VisitPrecedingTitle(path, n)
+		}
+	case *Suffix:
+		if v, ok := v.(SuffixVisitor); ok {
+			return v.VisitSuffix(path, n)
+		}
+	case *Title:
+		if v, ok := v.(TitleVisitor); ok {
+			return v.VisitTitle(path, n)
+		}
+	}
+	return nil
+}
+
+// walkChildren walks the non-nil children of node, in field order.
+func walkChildren(path Path, node any, fn WalkFunc) error {
+	switch n := node.(type) {
+	case *XAL:
+		for i, c := range n.AddressDetails {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_details", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *AddressDetails:
+		if n.PostalServiceElements != nil {
+			if err := walk(path.child("postal_service_elements"), n.PostalServiceElements, fn); err != nil {
+				return err
+			}
+		}
+		if n.Address != nil {
+			if err := walk(path.child("address"), n.Address, fn); err != nil {
+				return err
+			}
+		}
+		if n.AddressLines != nil {
+			if err := walk(path.child("address_lines"), n.AddressLines, fn); err != nil {
+				return err
+			}
+		}
+		if n.Country != nil {
+			if err := walk(path.child("country"), n.Country, fn); err != nil {
+				return err
+			}
+		}
+		if n.AdministrativeArea != nil {
+			if err := walk(path.child("administrative_area"), n.AdministrativeArea, fn); err != nil {
+				return err
+			}
+		}
+		if n.Locality != nil {
+			if err := walk(path.child("locality"), n.Locality, fn); err != nil {
+				return err
+			}
+		}
+		if n.Thoroughfare != nil {
+			if err := walk(path.child("thoroughfare"), n.Thoroughfare, fn); err != nil {
+				return err
+			}
+		}
+	case *AddressLines:
+		for i, c := range *n {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child(strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *AdministrativeArea:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.AdministrativeAreaName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("administrative_area_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.SubAdministrativeArea != nil {
+			if err := walk(path.child("sub_administrative_area"), n.SubAdministrativeArea, fn); err != nil {
+				return err
+			}
+		}
+		if n.Locality != nil {
+			if err := walk(path.child("locality"), n.Locality, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostOffice != nil {
+			if err := walk(path.child("post_office"), n.PostOffice, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *Country:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.CountryNameCode {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("country_name_code", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.CountryName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("country_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.AdministrativeArea != nil {
+			if err := walk(path.child("administrative_area"), n.AdministrativeArea, fn); err != nil {
+				return err
+			}
+		}
+		if n.Locality != nil {
+			if err := walk(path.child("locality"), n.Locality, fn); err != nil {
+				return err
+			}
+		}
+		if n.Thoroughfare != nil {
+			if err := walk(path.child("thoroughfare"), n.Thoroughfare, fn); err != nil {
+				return err
+			}
+		}
+	case *Department:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.DepartmentName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("department_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.MailStop != nil {
+			if err := walk(path.child("mail_stop"), n.MailStop, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *DependentLocality:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.DependentLocalityName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("dependent_locality_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.DependentLocalityNumber != nil {
+			if err := walk(path.child("dependent_locality_number"), n.DependentLocalityNumber, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBox != nil {
+			if err := walk(path.child("post_box"), n.PostBox, fn); err != nil {
+				return err
+			}
+		}
+		if n.LargeMailUser != nil {
+			if err := walk(path.child("large_mail_user"), n.LargeMailUser, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostOffice != nil {
+			if err := walk(path.child("post_office"), n.PostOffice, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalRoute != nil {
+			if err := walk(path.child("postal_route"), n.PostalRoute, fn); err != nil {
+				return err
+			}
+		}
+		if n.Thoroughfare != nil {
+			if err := walk(path.child("thoroughfare"), n.Thoroughfare, fn); err != nil {
+				return err
+			}
+		}
+		if n.Premise != nil {
+			if err := walk(path.child("premise"), n.Premise, fn); err != nil {
+				return err
+			}
+		}
+		if n.DependentLocality != nil {
+			if err := walk(path.child("dependent_locality"), n.DependentLocality, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *DependentThoroughfare:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfarePreDirection != nil {
+			if err := walk(path.child("thoroughfare_pre_direction"), n.ThoroughfarePreDirection, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfareLeadingType != nil {
+			if err := walk(path.child("thoroughfare_leading_type"), n.ThoroughfareLeadingType, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfareTrailingType != nil {
+			if err := walk(path.child("thoroughfare_trailing_type"), n.ThoroughfareTrailingType, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfarePostDirection != nil {
+			if err := walk(path.child("thoroughfare_post_direction"), n.ThoroughfarePostDirection, fn); err != nil {
+				return err
+			}
+		}
+	case *Firm:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.FirmName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("firm_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Department {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("department", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.MailStop != nil {
+			if err := walk(path.child("mail_stop"), n.MailStop, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *LargeMailUser:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.LargeMailUserName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("large_mail_user_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.LargeMailUserIdentifier != nil {
+			if err := walk(path.child("large_mail_user_identifier"), n.LargeMailUserIdentifier, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.BuildingName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("building_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.Department != nil {
+			if err := walk(path.child("department"), n.Department, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBox != nil {
+			if err := walk(path.child("post_box"), n.PostBox, fn); err != nil {
+				return err
+			}
+		}
+		if n.Thoroughfare != nil {
+			if err := walk(path.child("thoroughfare"), n.Thoroughfare, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *Locality:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.LocalityName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("locality_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBox != nil {
+			if err := walk(path.child("post_box"), n.PostBox, fn); err != nil {
+				return err
+			}
+		}
+		if n.LargeMailUser != nil {
+			if err := walk(path.child("large_mail_user"), n.LargeMailUser, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostOffice != nil {
+			if err := walk(path.child("post_office"), n.PostOffice, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalRoute != nil {
+			if err := walk(path.child("postal_route"), n.PostalRoute, fn); err != nil {
+				return err
+			}
+		}
+		if n.Thoroughfare != nil {
+			if err := walk(path.child("thoroughfare"), n.Thoroughfare, fn); err != nil {
+				return err
+			}
+		}
+		if n.Premise != nil {
+			if err := walk(path.child("premise"), n.Premise, fn); err != nil {
+				return err
+			}
+		}
+		if n.DependentLocality != nil {
+			if err := walk(path.child("dependent_locality"), n.DependentLocality, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *MailStop:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.MailStopName != nil {
+			if err := walk(path.child("mail_stop_name"), n.MailStopName, fn); err != nil {
+				return err
+			}
+		}
+		if n.MailStopNumber != nil {
+			if err := walk(path.child("mail_stop_number"), n.MailStopNumber, fn); err != nil {
+				return err
+			}
+		}
+	case *PostBox:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBoxNumber != nil {
+			if err := walk(path.child("post_box_number"), n.PostBoxNumber, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBoxNumberPrefix != nil {
+			if err := walk(path.child("post_box_number_prefix"), n.PostBoxNumberPrefix, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBoxNumberSuffix != nil {
+			if err := walk(path.child("post_box_number_suffix"), n.PostBoxNumberSuffix, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBoxNumberExtension != nil {
+			if err := walk(path.child("post_box_number_extension"), n.PostBoxNumberExtension, fn); err != nil {
+				return err
+			}
+		}
+		if n.Firm != nil {
+			if err := walk(path.child("firm"), n.Firm, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *PostOffice:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PostOfficeName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("post_office_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostOfficeNumber != nil {
+			if err := walk(path.child("post_office_number"), n.PostOfficeNumber, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalRoute != nil {
+			if err := walk(path.child("postal_route"), n.PostalRoute, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBox != nil {
+			if err := walk(path.child("post_box"), n.PostBox, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *PostTown:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PostTownName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("post_town_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostTownSuffix != nil {
+			if err := walk(path.child("post_town_suffix"), n.PostTownSuffix, fn); err != nil {
+				return err
+			}
+		}
+	case *PostalCode:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PostalCodeNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("postal_code_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PostalCodeNumberExtension {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("postal_code_number_extension", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostTown != nil {
+			if err := walk(path.child("post_town"), n.PostTown, fn); err != nil {
+				return err
+			}
+		}
+	case *PostalRoute:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PostalRouteName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("postal_route_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalRouteNumber != nil {
+			if err := walk(path.child("postal_route_number"), n.PostalRouteNumber, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostBox != nil {
+			if err := walk(path.child("post_box"), n.PostBox, fn); err != nil {
+				return err
+			}
+		}
+	case *PostalServiceElements:
+		for i, c := range n.AddressIdentifier {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_identifier", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.EndorsementLineCode != nil {
+			if err := walk(path.child("endorsement_line_code"), n.EndorsementLineCode, fn); err != nil {
+				return err
+			}
+		}
+		if n.KeyLineCode != nil {
+			if err := walk(path.child("key_line_code"), n.KeyLineCode, fn); err != nil {
+				return err
+			}
+		}
+		if n.Barcode != nil {
+			if err := walk(path.child("barcode"), n.Barcode, fn); err != nil {
+				return err
+			}
+		}
+		if n.SortingCode != nil {
+			if err := walk(path.child("sorting_code"), n.SortingCode, fn); err != nil {
+				return err
+			}
+		}
+		if n.AddressLatitude != nil {
+			if err := walk(path.child("address_latitude"), n.AddressLatitude, fn); err != nil {
+				return err
+			}
+		}
+		if n.AddressLatitudeDirection != nil {
+			if err := walk(path.child("address_latitude_direction"), n.AddressLatitudeDirection, fn); err != nil {
+				return err
+			}
+		}
+		if n.AddressLongitude != nil {
+			if err := walk(path.child("address_longitude"), n.AddressLongitude, fn); err != nil {
+				return err
+			}
+		}
+		if n.AddressLongitudeDirection != nil {
+			if err := walk(path.child("address_longitude_direction"), n.AddressLongitudeDirection, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SupplementaryPostalServiceData {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("supplementary_postal_service_data", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *Premise:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PremiseLocation != nil {
+			if err := walk(path.child("premise_location"), n.PremiseLocation, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PremiseNumberRange != nil {
+			if err := walk(path.child("premise_number_range"), n.PremiseNumberRange, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.BuildingName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("building_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SubPremise {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("sub_premise", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.Firm != nil {
+			if err := walk(path.child("firm"), n.Firm, fn); err != nil {
+				return err
+			}
+		}
+		if n.MailStop != nil {
+			if err := walk(path.child("mail_stop"), n.MailStop, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+		if n.Premise != nil {
+			if err := walk(path.child("premise"), n.Premise, fn); err != nil {
+				return err
+			}
+		}
+	case *PremiseNumberRange:
+		if n.PremiseNumberRangeFrom != nil {
+			if err := walk(path.child("premise_number_range_from"), n.PremiseNumberRangeFrom, fn); err != nil {
+				return err
+			}
+		}
+		if n.PremiseNumberRangeTo != nil {
+			if err := walk(path.child("premise_number_range_to"), n.PremiseNumberRangeTo, fn); err != nil {
+				return err
+			}
+		}
+	case *PremiseNumberRangeFrom:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *PremiseNumberRangeTo:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PremiseNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("premise_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *SubAdministrativeArea:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SubAdministrativeAreaName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("sub_administrative_area_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.Locality != nil {
+			if err := walk(path.child("locality"), n.Locality, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostOffice != nil {
+			if err := walk(path.child("post_office"), n.PostOffice, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *SubPremise:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SubPremiseName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("sub_premise_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.SubPremiseLocation != nil {
+			if err := walk(path.child("sub_premise_location"), n.SubPremiseLocation, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SubPremiseNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("sub_premise_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SubPremiseNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("sub_premise_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.SubPremiseNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("sub_premise_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.BuildingName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("building_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.Firm != nil {
+			if err := walk(path.child("firm"), n.Firm, fn); err != nil {
+				return err
+			}
+		}
+		if n.MailStop != nil {
+			if err := walk(path.child("mail_stop"), n.MailStop, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+		if n.SubPremise != nil {
+			if err := walk(path.child("sub_premise"), n.SubPremise, fn); err != nil {
+				return err
+			}
+		}
+	case *Thoroughfare:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberRange {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_range", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfarePreDirection != nil {
+			if err := walk(path.child("thoroughfare_pre_direction"), n.ThoroughfarePreDirection, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfareLeadingType != nil {
+			if err := walk(path.child("thoroughfare_leading_type"), n.ThoroughfareLeadingType, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfareTrailingType != nil {
+			if err := walk(path.child("thoroughfare_trailing_type"), n.ThoroughfareTrailingType, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfarePostDirection != nil {
+			if err := walk(path.child("thoroughfare_post_direction"), n.ThoroughfarePostDirection, fn); err != nil {
+				return err
+			}
+		}
+		if n.DependentThoroughfare != nil {
+			if err := walk(path.child("dependent_thoroughfare"), n.DependentThoroughfare, fn); err != nil {
+				return err
+			}
+		}
+		if n.DependentLocality != nil {
+			if err := walk(path.child("dependent_locality"), n.DependentLocality, fn); err != nil {
+				return err
+			}
+		}
+		if n.Premise != nil {
+			if err := walk(path.child("premise"), n.Premise, fn); err != nil {
+				return err
+			}
+		}
+		if n.Firm != nil {
+			if err := walk(path.child("firm"), n.Firm, fn); err != nil {
+				return err
+			}
+		}
+		if n.PostalCode != nil {
+			if err := walk(path.child("postal_code"), n.PostalCode, fn); err != nil {
+				return err
+			}
+		}
+	case *ThoroughfareNumberFrom:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *ThoroughfareNumberRange:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfareNumberFrom != nil {
+			if err := walk(path.child("thoroughfare_number_from"), n.ThoroughfareNumberFrom, fn); err != nil {
+				return err
+			}
+		}
+		if n.ThoroughfareNumberTo != nil {
+			if err := walk(path.child("thoroughfare_number_to"), n.ThoroughfareNumberTo, fn); err != nil {
+				return err
+			}
+		}
+	case *ThoroughfareNumberTo:
+		for i, c := range n.AddressLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("address_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberPrefix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_prefix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumber {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.ThoroughfareNumberSuffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("thoroughfare_number_suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *XNL:
+		for i, c := range n.NameDetails {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_details", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *DependencyName:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PersonName != nil {
+			if err := walk(path.child("person_name"), n.PersonName, fn); err != nil {
+				return err
+			}
+		}
+		if n.JointPersonName != nil {
+			if err := walk(path.child("joint_person_name"), n.JointPersonName, fn); err != nil {
+				return err
+			}
+		}
+		if n.OrganisationNameDetails != nil {
+			if err := walk(path.child("organisation_name_details"), n.OrganisationNameDetails, fn); err != nil {
+				return err
+			}
+		}
+	case *FormerName:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PrecedingTitle {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("preceding_title", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Title {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("title", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.FirstName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("first_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.MiddleName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("middle_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.NamePrefix != nil {
+			if err := walk(path.child("name_prefix"), n.NamePrefix, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.LastName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("last_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OtherName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("other_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Alias {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("alias", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.GenerationIdentifier {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("generation_identifier", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Suffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.GeneralSuffix != nil {
+			if err := walk(path.child("general_suffix"), n.GeneralSuffix, fn); err != nil {
+				return err
+			}
+		}
+	case *JointPersonName:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PersonName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("person_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *KnownAs:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PrecedingTitle {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("preceding_title", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Title {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("title", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.FirstName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("first_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.MiddleName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("middle_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.NamePrefix != nil {
+			if err := walk(path.child("name_prefix"), n.NamePrefix, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.LastName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("last_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OtherName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("other_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Alias {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("alias", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.GenerationIdentifier {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("generation_identifier", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Suffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.GeneralSuffix != nil {
+			if err := walk(path.child("general_suffix"), n.GeneralSuffix, fn); err != nil {
+				return err
+			}
+		}
+	case *NameDetails:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.PersonName != nil {
+			if err := walk(path.child("person_name"), n.PersonName, fn); err != nil {
+				return err
+			}
+		}
+		if n.JointPersonName != nil {
+			if err := walk(path.child("joint_person_name"), n.JointPersonName, fn); err != nil {
+				return err
+			}
+		}
+		if n.OrganisationNameDetails != nil {
+			if err := walk(path.child("organisation_name_details"), n.OrganisationNameDetails, fn); err != nil {
+				return err
+			}
+		}
+		if n.AddresseeIndicator != nil {
+			if err := walk(path.child("addressee_indicator"), n.AddresseeIndicator, fn); err != nil {
+				return err
+			}
+		}
+		if n.Function != nil {
+			if err := walk(path.child("function"), n.Function, fn); err != nil {
+				return err
+			}
+		}
+		if n.DependencyName != nil {
+			if err := walk(path.child("dependency_name"), n.DependencyName, fn); err != nil {
+				return err
+			}
+		}
+	case *OrganisationFormerName:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationType {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_type", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *OrganisationKnownAs:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationType {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_type", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *OrganisationNameDetails:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationType {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_type", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationFormerName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_former_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OrganisationKnownAs {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("organisation_known_as", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	case *PersonName:
+		for i, c := range n.NameLine {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("name_line", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.PrecedingTitle {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("preceding_title", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Title {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("title", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.FirstName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("first_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.MiddleName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("middle_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.NamePrefix != nil {
+			if err := walk(path.child("name_prefix"), n.NamePrefix, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.LastName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("last_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.OtherName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("other_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Alias {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("alias", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.GenerationIdentifier {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("generation_identifier", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.Suffix {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("suffix", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		if n.GeneralSuffix != nil {
+			if err := walk(path.child("general_suffix"), n.GeneralSuffix, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.FormerName {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("former_name", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+		for i, c := range n.KnownAs {
+			if c == nil {
+				continue
+			}
+			if err := walk(path.child("known_as", strconv.Itoa(i)), c, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateNode checks the maxLength and choice constraints of the fields of node.
+func validateNode(path Path, node any) (errs ValidationErrors) {
+	switch n := node.(type) {
+	case *AddressDetails:
+		errs = errs.checkLength(path, "attr_address_type", n.AttrAddressType, 23)
+		errs = errs.checkLength(path, "attr_current_status", n.AttrCurrentStatus, 10)
+		errs = errs.checkLength(path, "attr_valid_from_date", n.AttrValidFromDate, 11)
+		errs = errs.checkLength(path, "attr_valid_to_date", n.AttrValidToDate, 13)
+		errs = errs.checkLength(path, "attr_usage", n.AttrUsage, 6)
+		errs = errs.checkChoice(path, []string{"address", "address_lines", "country", "administrative_area", "locality", "thoroughfare"}, n.Address != nil, n.AddressLines != nil, n.Country != nil, n.AdministrativeArea != nil, n.Locality != nil, n.Thoroughfare != nil)
+	case *AdministrativeArea:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 8)
+		errs = errs.checkChoice(path, []string{"locality", "post_office", "postal_code"}, n.Locality != nil, n.PostOffice != nil, n.PostalCode != nil)
+	case *AdministrativeAreaName:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 12)
+	case *BuildingName:
+		errs = errs.checkEnum(path, "attr_type_occurrence", n.AttrTypeOccurrence, "Before", "After")
+	case *Country:
+		errs = errs.checkChoice(path, []string{"administrative_area", "locality", "thoroughfare"}, n.AdministrativeArea != nil, n.Locality != nil, n.Thoroughfare != nil)
+	case *DependentLocality:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 12)
+		errs = errs.checkLength(path, "attr_connector", n.AttrConnector, 25)
+		errs = errs.checkChoice(path, []string{"post_box", "large_mail_user", "post_office", "postal_route"}, n.PostBox != nil, n.LargeMailUser != nil, n.PostOffice != nil, n.PostalRoute != nil)
+	case *DependentLocalityName:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 12)
+	case *DependentLocalityNumber:
+		errs = errs.checkLength(path, "attr_name_number_occurrence", n.AttrNameNumberOccurrence, 6)
+		errs = errs.checkEnum(path, "attr_name_number_occurrence", n.AttrNameNumberOccurrence, "Before", "After")
+	case *LargeMailUser:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 8)
+	case *LargeMailUserIdentifier:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 14)
+	case *Locality:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 8)
+		errs = errs.checkChoice(path, []string{"post_box", "large_mail_user", "post_office", "postal_route"}, n.PostBox != nil, n.LargeMailUser != nil, n.PostOffice != nil, n.PostalRoute != nil)
+	case *LocalityName:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 12)
+	case *PostBox:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 5)
+	case *PostOffice:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 14)
+		errs = errs.checkChoice(path, []string{"post_office_name", "post_office_number"}, n.PostOfficeName != nil, n.PostOfficeNumber != nil)
+	case *PostOfficeNumber:
+		errs = errs.checkLength(path, "attr_indicator", n.AttrIndicator, 3)
+		errs = errs.checkEnum(path, "attr_indicator_occurrence", n.AttrIndicatorOccurrence, "Before", "After")
+	case *PostalCode:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 9)
+	case *PostalCodeNumberExtension:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 19)
+	case *PostalRoute:
+		errs = errs.checkChoice(path, []string{"postal_route_name", "postal_route_number"}, n.PostalRouteName != nil, n.PostalRouteNumber != nil)
+	case *Premise:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 18)
+		errs = errs.checkLength(path, "attr_premise_dependency", n.AttrPremiseDependency, 7)
+		errs = errs.checkLength(path, "attr_premise_dependency_type", n.AttrPremiseDependencyType, 19)
+		errs = errs.checkChoice(path, []string{"premise_location", "premise_number", "premise_number_range", "sub_premise", "firm"}, n.PremiseLocation != nil, n.PremiseNumber != nil, n.PremiseNumberRange != nil, n.SubPremise != nil, n.Firm != nil)
+	case *PremiseName:
+		errs = errs.checkEnum(path, "attr_type_occurrence", n.AttrTypeOccurrence, "Before", "After")
+	case *PremiseNumber:
+		errs = errs.checkEnum(path, "attr_number_type", n.AttrNumberType, "Single", "Range")
+		errs = errs.checkEnum(path, "attr_indicator_occurrence", n.AttrIndicatorOccurrence, "Before", "After")
+		errs = errs.checkEnum(path, "attr_number_type_occurrence", n.AttrNumberTypeOccurrence, "Before", "After")
+	case *PremiseNumberRange:
+		errs = errs.checkEnum(path, "attr_indicator_occurence", n.AttrIndicatorOccurence, "Before", "After")
+		errs = errs.checkEnum(path, "attr_number_range_occurence", n.AttrNumberRangeOccurence, "BeforeName", "AfterName", "BeforeType", "AfterType")
+	case *SubAdministrativeArea:
+		errs = errs.checkChoice(path, []string{"locality", "post_office", "postal_code"}, n.Locality != nil, n.PostOffice != nil, n.PostalCode != nil)
+	case *SubPremise:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 9)
+		errs = errs.checkChoice(path, []string{"sub_premise_location", "sub_premise_number"}, n.SubPremiseLocation != nil, n.SubPremiseNumber != nil)
+	case *SubPremiseName:
+		errs = errs.checkEnum(path, "attr_type_occurrence", n.AttrTypeOccurrence, "Before", "After")
+	case *SubPremiseNumber:
+		errs = errs.checkEnum(path, "attr_indicator_occurrence", n.AttrIndicatorOccurrence, "Before", "After")
+		errs = errs.checkEnum(path, "attr_number_type_occurrence", n.AttrNumberTypeOccurrence, "Before", "After")
+	case *Thoroughfare:
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 6)
+		errs = errs.checkLength(path, "attr_dependent_thoroughfares", n.AttrDependentThoroughfares, 3)
+		errs = errs.checkEnum(path, "attr_dependent_thoroughfares", n.AttrDependentThoroughfares, "Yes", "No")
+		errs = errs.checkLength(path, "attr_dependent_thoroughfares_indicator", n.AttrDependentThoroughfaresIndicator, 9)
+		errs = errs.checkLength(path, "attr_dependent_thoroughfares_connector", n.AttrDependentThoroughfaresConnector, 3)
+		errs = errs.checkChoice(path, []string{"dependent_locality", "premise", "firm", "postal_code"}, n.DependentLocality != nil, n.Premise != nil, n.Firm != nil, n.PostalCode != nil)
+	case *ThoroughfareNumber:
+		errs = errs.checkEnum(path, "attr_number_type", n.AttrNumberType, "Single", "Range")
+		errs = errs.checkLength(path, "attr_indicator", n.AttrIndicator, 3)
+		errs = errs.checkLength(path, "attr_indicator_occurrence", n.AttrIndicatorOccurrence, 6)
+		errs = errs.checkEnum(path, "attr_indicator_occurrence", n.AttrIndicatorOccurrence, "Before", "After")
+		errs = errs.checkEnum(path, "attr_number_occurrence", n.AttrNumberOccurrence, "BeforeName", "AfterName", "BeforeType", "AfterType")
+	case *ThoroughfareNumberRange:
+		errs = errs.checkEnum(path, "attr_range_type", n.AttrRangeType, "Odd", "Even")
+		errs = errs.checkLength(path, "attr_indicator", n.AttrIndicator, 2)
+		errs = errs.checkEnum(path, "attr_indicator_occurrence", n.AttrIndicatorOccurrence, "Before", "After")
+		errs = errs.checkEnum(path, "attr_number_range_occurrence", n.AttrNumberRangeOccurrence, "BeforeName", "AfterName", "BeforeType", "AfterType")
+		errs = errs.checkLength(path, "attr_type", n.AttrType, 4)
+	case *DependencyName:
+		errs = errs.checkChoice(path, []string{"name_line", "person_name", "joint_person_name", "organisation_name_details"}, n.NameLine != nil, n.PersonName != nil, n.JointPersonName != nil, n.OrganisationNameDetails != nil)
+	case *NameDetails:
+		errs = errs.checkChoice(path, []string{"name_line", "person_name", "joint_person_name", "organisation_name_details"}, n.NameLine != nil, n.PersonName != nil, n.JointPersonName != nil, n.OrganisationNameDetails != nil)
+	}
+	return errs
+}
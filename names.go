@@ -0,0 +1,105 @@
+package xal
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Lines returns the name as lines of text, eg. Mr John Smith, for the formats that carry names as free text.
+//
+// Name lines are kept as is. A person name gives a line of its titles, names and suffixes, in the order of
+// the model, and the person names of a joint person name are joined with the connector, eg. Mr Hunt AND Mrs Clark.
+// An organisation name is followed by its type, eg. MSI Business Solutions Pty. Ltd.
+// The addressee indicator, the function and the dependency name are left out.
+func (n *NameDetails) Lines() []string {
+	if n == nil {
+		return nil
+	}
+	switch {
+	case len(n.NameLine) > 0:
+		return nameLines(n.NameLine)
+	case n.PersonName != nil:
+		return nonEmpty([]string{personName(n.PersonName)})
+	case n.JointPersonName != nil:
+		j := n.JointPersonName
+		if len(j.NameLine) > 0 {
+			return nameLines(j.NameLine)
+		}
+		var names []string
+		for _, p := range j.PersonName {
+			names = append(names, personName(p))
+		}
+		connector := " " + strings.TrimSpace(j.AttrJointNameConnector) + " "
+		if connector == "  " {
+			connector = ", "
+		}
+		return nonEmpty([]string{strings.Join(nonEmpty(names), connector)})
+	case n.OrganisationNameDetails != nil:
+		o := n.OrganisationNameDetails
+		if len(o.NameLine) > 0 {
+			return nameLines(o.NameLine)
+		}
+		var parts []string
+		for _, on := range o.OrganisationName {
+			if on != nil {
+				parts = append(parts, on.Text)
+			}
+		}
+		for _, ot := range o.OrganisationType {
+			if ot != nil {
+				parts = append(parts, ot.Text)
+			}
+		}
+		return nonEmpty([]string{strings.Join(nonEmpty(parts), " ")})
+	}
+	return nil
+}
+
+// NameFromLines returns a name made of name lines, or nil if there are none.
+func NameFromLines(lines ...string) *NameDetails {
+	lines = nonEmpty(lines)
+	if len(lines) == 0 {
+		return nil
+	}
+	n := &NameDetails{}
+	for _, l := range lines {
+		n.NameLine = append(n.NameLine, &NameLine{Text: l})
+	}
+	return n
+}
+
+// nameLines returns the non-empty texts of lines.
+func nameLines(lines []*NameLine) []string {
+	var out []string
+	for _, l := range lines {
+		if l != nil {
+			out = append(out, l.Text)
+		}
+	}
+	return nonEmpty(out)
+}
+
+// personName returns the person name as a single line of its titles, names and suffixes,
+// leaving out the aliases, the general suffix and the former and known as names.
+func personName(p *PersonName) string {
+	if p == nil {
+		return ""
+	}
+	if len(p.NameLine) > 0 {
+		return strings.Join(nameLines(p.NameLine), " ")
+	}
+	var parts []string
+	Walk(p, func(path Path, node any) error {
+		switch node.(type) {
+		case *PersonName:
+			return nil
+		case *NameLine, *Alias, *GeneralSuffix, *FormerName, *KnownAs:
+			return SkipSubtree
+		}
+		if v := reflect.ValueOf(node).Elem().FieldByName("Text"); v.IsValid() {
+			parts = append(parts, v.String())
+		}
+		return nil
+	})
+	return strings.Join(nonEmpty(parts), " ")
+}
@@ -0,0 +1,96 @@
+package xal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// abbreviations maps common abbreviations found in addresses to their expanded form.
+var abbreviations = map[string]string{
+	"apt":  "apartment",
+	"av":   "avenue",
+	"ave":  "avenue",
+	"bldg": "building",
+	"blvd": "boulevard",
+	"cres": "crescent",
+	"ct":   "court",
+	"dr":   "drive",
+	"e":    "east",
+	"fl":   "floor",
+	"hwy":  "highway",
+	"ln":   "lane",
+	"mt":   "mount",
+	"n":    "north",
+	"ne":   "northeast",
+	"nw":   "northwest",
+	"pkwy": "parkway",
+	"pl":   "place",
+	"rd":   "road",
+	"s":    "south",
+	"se":   "southeast",
+	"sq":   "square",
+	"st":   "street",
+	"ste":  "suite",
+	"str":  "strasse",
+	"sw":   "southwest",
+	"ter":  "terrace",
+	"w":    "west",
+}
+
+// normalize folds s for comparison: it is lower-cased, punctuation is dropped,
+// whitespace is collapsed and known abbreviations are expanded.
+func normalize(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		if full, ok := abbreviations[w]; ok {
+			words[i] = full
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// normalizeCode folds an identifier such as a postal code or a premise number:
+// it is upper-cased and everything but letters and digits is dropped.
+func normalizeCode(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, s)
+}
+
+// similarity returns a score between 0 and 1 derived from the Levenshtein distance between a and b.
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	n := max(len(ra), len(rb))
+	if n == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(n)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
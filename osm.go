@@ -0,0 +1,227 @@
+package xal
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ErrNoNumberRange is returned when converting an address without a ThoroughfareNumberRange to an interpolation way.
+var ErrNoNumberRange = errors.New("xal: address has no thoroughfare number range")
+
+// OSMTags - The tags of an OpenStreetMap node, way or relation, eg. addr:street=Market Street.
+type OSMTags map[string]string
+
+// OSMInterpolation - An OpenStreetMap address interpolation way: a way tagged addr:interpolation
+// whose first and last nodes carry the house numbers at the ends of the range.
+type OSMInterpolation struct {
+	Tags OSMTags `json:"tags"` // Tags of the way, eg. addr:interpolation=odd and addr:street=Market Street
+	From OSMTags `json:"from"` // Tags of the first node, eg. addr:housenumber=1
+	To   OSMTags `json:"to"`   // Tags of the last node, eg. addr:housenumber=99
+}
+
+// osmNumberTags are the tags holding the number of a single address.
+var osmNumberTags = []string{"addr:housenumber", "addr:conscriptionnumber", "addr:streetnumber"}
+
+// ToOSM converts the address to addr:* tags.
+//
+// The dependent locality goes to addr:suburb, or to addr:place for addresses without a street.
+// A premise number whose AttrType is Conscription, as used in CZ and SK, goes to addr:conscriptionnumber,
+// the thoroughfare number to addr:streetnumber, and both to addr:housenumber, eg. 123/5.
+// The report lists what the tags cannot carry, eg. unit types and post boxes.
+func ToOSM(a *AddressDetails) (OSMTags, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	tags, carried := f.osmTags()
+	return tags, f.dropped(carried...)
+}
+
+func (f *flattener) osmTags() (OSMTags, []Component) {
+	flat := f.flat
+	tags := OSMTags{}
+	var carried []Component
+	set := func(key, value string, cs ...Component) {
+		if value != "" {
+			tags[key] = value
+			carried = append(carried, cs...)
+		}
+	}
+	street := joinNonEmpty([]string{
+		flat[ComponentStreetPreDirection], flat[ComponentStreetLeadingType], flat[ComponentStreetName],
+		flat[ComponentStreetType], flat[ComponentStreetPostDirection],
+	}, " ")
+	set("addr:street", street, ComponentStreetPreDirection, ComponentStreetLeadingType, ComponentStreetName,
+		ComponentStreetType, ComponentStreetPostDirection)
+
+	number := flat[ComponentHouseNumber] + flat[ComponentHouseNumberSuffix]
+	if p := f.root.premise(); p != nil && len(p.PremiseNumber) > 0 && strings.EqualFold(p.PremiseNumber[0].AttrType, "Conscription") {
+		f.use(p.PremiseNumber[0], "AttrType")
+		set("addr:conscriptionnumber", number, ComponentHouseNumber, ComponentHouseNumberSuffix)
+		if t := f.root.thoroughfare(); t != nil && len(t.ThoroughfareNumber) > 0 && t.ThoroughfareNumber[0].Text != "" {
+			f.use(t.ThoroughfareNumber[0], "Text")
+			set("addr:streetnumber", t.ThoroughfareNumber[0].Text)
+			number += "/" + t.ThoroughfareNumber[0].Text
+		}
+	}
+	set("addr:housenumber", number, ComponentHouseNumber, ComponentHouseNumberSuffix)
+	if name := flat[ComponentPremiseName]; name != "" {
+		set("addr:housename", name, ComponentPremiseName)
+	} else {
+		set("addr:housename", flat[ComponentBuildingName], ComponentBuildingName)
+	}
+	set("addr:unit", flat[ComponentUnit], ComponentUnit)
+	if street != "" {
+		set("addr:suburb", flat[ComponentDependentLocality], ComponentDependentLocality)
+	} else {
+		set("addr:place", flat[ComponentDependentLocality], ComponentDependentLocality)
+	}
+	set("addr:city", flat[ComponentLocality], ComponentLocality)
+	set("addr:state", flat[ComponentAdminArea], ComponentAdminArea)
+	set("addr:postcode", joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		ComponentPostalCode, ComponentPostalCodeExtension)
+	set("addr:country", flat[ComponentCountryCode], ComponentCountryCode)
+	return tags, carried
+}
+
+// FromOSM converts addr:* tags to an address.
+//
+// addr:place is used as the dependent locality when there is no addr:suburb. With addr:conscriptionnumber,
+// the conscription number becomes the premise number, with AttrType Conscription, and addr:streetnumber,
+// or else the part of addr:housenumber after the slash, the thoroughfare number.
+// The report lists the addr:* tags that were not converted, as JSON Pointers into the tags.
+// Tags outside of the addr: namespace are ignored.
+func FromOSM(tags OSMTags) (*AddressDetails, Report) {
+	return fromOSM(tags, nil)
+}
+
+// fromOSM converts tags found at path base of the source.
+func fromOSM(tags OSMTags, base Path) (*AddressDetails, Report) {
+	used := map[string]bool{}
+	get := func(key string) string {
+		v := strings.TrimSpace(tags[key])
+		if v != "" {
+			used[key] = true
+		}
+		return v
+	}
+	flat := Flat{
+		ComponentStreetName:        get("addr:street"),
+		ComponentPremiseName:       get("addr:housename"),
+		ComponentUnit:              get("addr:unit"),
+		ComponentLocality:          get("addr:city"),
+		ComponentAdminArea:         get("addr:state"),
+		ComponentPostalCode:        get("addr:postcode"),
+		ComponentCountryCode:       strings.ToUpper(get("addr:country")),
+		ComponentHouseNumber:       get("addr:housenumber"),
+		ComponentDependentLocality: get("addr:suburb"),
+	}
+	if flat[ComponentDependentLocality] == "" {
+		flat[ComponentDependentLocality] = get("addr:place")
+	}
+	conscription, streetNumber := get("addr:conscriptionnumber"), get("addr:streetnumber")
+	if conscription != "" {
+		if streetNumber == "" {
+			_, streetNumber, _ = strings.Cut(flat[ComponentHouseNumber], "/")
+		}
+		delete(flat, ComponentHouseNumber)
+	}
+	a := flat.build()
+	if conscription != "" {
+		s := a.In(BranchCountry)
+		s.premise().PremiseNumber = []*PremiseNumber{{AttrType: "Conscription", Text: conscription}}
+		if streetNumber != "" {
+			s.thoroughfare().ThoroughfareNumber = []*ThoroughfareNumber{{Text: streetNumber}}
+		}
+	}
+	var r Report
+	for key, v := range tags {
+		if strings.HasPrefix(key, "addr:") && !used[key] && strings.TrimSpace(v) != "" {
+			r.Dropped = append(r.Dropped, base.child(key).String())
+		}
+	}
+	sort.Strings(r.Dropped)
+	return a, r
+}
+
+// ToOSMInterpolation converts an address holding a ThoroughfareNumberRange to an interpolation way.
+//
+// The way carries the addr:* tags of the address but its house numbers, and addr:interpolation,
+// odd or even for the ranges of these RangeType and all otherwise. The numbers of the first range go to the end nodes.
+// It returns ErrNoNumberRange if the thoroughfare of the address has no number range.
+func ToOSMInterpolation(a *AddressDetails) (*OSMInterpolation, Report, error) {
+	t := a.thoroughfare()
+	if t == nil || len(t.ThoroughfareNumberRange) == 0 {
+		return nil, Report{}, ErrNoNumberRange
+	}
+	rng := t.ThoroughfareNumberRange[0]
+	f := newFlattener(a)
+	tags, carried := f.osmTags()
+	for _, key := range osmNumberTags {
+		delete(tags, key)
+	}
+	carried = slices.DeleteFunc(carried, func(c Component) bool {
+		return c == ComponentHouseNumber || c == ComponentHouseNumberSuffix
+	})
+	w := &OSMInterpolation{Tags: tags, From: OSMTags{}, To: OSMTags{}}
+	w.Tags["addr:interpolation"] = "all"
+	switch typ := strings.ToLower(rng.AttrRangeType); typ {
+	case "odd", "even":
+		w.Tags["addr:interpolation"] = typ
+		f.use(rng, "AttrRangeType")
+	}
+	if from := rng.ThoroughfareNumberFrom; from != nil && len(from.ThoroughfareNumber) > 0 && from.ThoroughfareNumber[0].Text != "" {
+		w.From["addr:housenumber"] = from.ThoroughfareNumber[0].Text
+		f.use(from.ThoroughfareNumber[0], "Text")
+	}
+	if to := rng.ThoroughfareNumberTo; to != nil && len(to.ThoroughfareNumber) > 0 && to.ThoroughfareNumber[0].Text != "" {
+		w.To["addr:housenumber"] = to.ThoroughfareNumber[0].Text
+		f.use(to.ThoroughfareNumber[0], "Text")
+	}
+	return w, f.dropped(carried...), nil
+}
+
+// FromOSMInterpolation converts an interpolation way to an address holding a ThoroughfareNumberRange,
+// from the addr:housenumber of the end nodes. The odd and even interpolations set the RangeType of the range.
+//
+// The report lists the addr:* tags that were not converted, including the alphabetic and numeric
+// interpolations and the tags of the end nodes that differ from the tags of the way.
+func FromOSMInterpolation(w *OSMInterpolation) (*AddressDetails, Report) {
+	if w == nil {
+		return nil, Report{}
+	}
+	tags := maps.Clone(w.Tags)
+	delete(tags, "addr:interpolation")
+	a, r := fromOSM(tags, Path{"tags"})
+
+	rng := &ThoroughfareNumberRange{}
+	switch typ := strings.ToLower(strings.TrimSpace(w.Tags["addr:interpolation"])); typ {
+	case "odd", "even":
+		rng.AttrRangeType = strings.ToUpper(typ[:1]) + typ[1:]
+	case "", "all":
+	default:
+		r.Dropped = append(r.Dropped, Path{"tags", "addr:interpolation"}.String())
+	}
+	if n := strings.TrimSpace(w.From["addr:housenumber"]); n != "" {
+		rng.ThoroughfareNumberFrom = &ThoroughfareNumberFrom{ThoroughfareNumber: []*ThoroughfareNumber{{Text: n}}}
+	}
+	if n := strings.TrimSpace(w.To["addr:housenumber"]); n != "" {
+		rng.ThoroughfareNumberTo = &ThoroughfareNumberTo{ThoroughfareNumber: []*ThoroughfareNumber{{Text: n}}}
+	}
+	a.In(BranchCountry).thoroughfare().ThoroughfareNumberRange = []*ThoroughfareNumberRange{rng}
+	for _, end := range []struct {
+		name string
+		tags OSMTags
+	}{{"from", w.From}, {"to", w.To}} {
+		for key, v := range end.tags {
+			if strings.HasPrefix(key, "addr:") && key != "addr:housenumber" && v != w.Tags[key] {
+				r.Dropped = append(r.Dropped, Path{end.name, key}.String())
+			}
+		}
+	}
+	sort.Strings(r.Dropped)
+	return a, r
+}
@@ -0,0 +1,116 @@
+package xal
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOSMRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		tags OSMTags
+	}{
+		{"street", OSMTags{
+			"addr:street": "Market Street", "addr:housenumber": "1", "addr:unit": "4", "addr:suburb": "SoMa",
+			"addr:city": "San Francisco", "addr:state": "CA", "addr:postcode": "94105", "addr:country": "US",
+		}},
+		{"place", OSMTags{"addr:housenumber": "12", "addr:place": "Ny-Ålesund", "addr:country": "NO"}},
+		{"house name", OSMTags{"addr:housename": "Rose Cottage", "addr:city": "Ambridge", "addr:country": "GB"}},
+		{"conscription number", OSMTags{
+			"addr:street": "Karlova", "addr:conscriptionnumber": "123", "addr:streetnumber": "5",
+			"addr:housenumber": "123/5", "addr:city": "Praha", "addr:country": "CZ",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r := FromOSM(tt.tags)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromOSM dropped %v", r.Dropped)
+			}
+			got, r := ToOSM(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToOSM dropped %v", r.Dropped)
+			}
+			if !reflect.DeepEqual(got, tt.tags) {
+				t.Errorf("got %v, want %v", got, tt.tags)
+			}
+		})
+	}
+}
+
+func TestOSMReport(t *testing.T) {
+	tags := OSMTags{"addr:city": "Oslo", "addr:floor": "3", "addr:door": "", "name": "Acme"}
+	if _, r := FromOSM(tags); strings.Join(r.Dropped, " ") != "/addr:floor" {
+		t.Errorf("FromOSM dropped %v, want [/addr:floor]", r.Dropped)
+	}
+
+	a := &AddressDetails{Country: &Country{
+		CountryNameCode: []*CountryNameCode{{Text: "NO"}},
+		Locality: &Locality{
+			LocalityName: []*LocalityName{{Text: "Oslo"}},
+			PostBox:      &PostBox{PostBoxNumber: &PostBoxNumber{Text: "12"}},
+		},
+	}}
+	got, r := ToOSM(a)
+	if !reflect.DeepEqual(got, OSMTags{"addr:city": "Oslo", "addr:country": "NO"}) {
+		t.Errorf("got %v", got)
+	}
+	if len(r.Dropped) != 1 {
+		t.Errorf("ToOSM dropped %v, want the post box", r.Dropped)
+	}
+}
+
+func TestOSMInterpolation(t *testing.T) {
+	tests := []struct {
+		name string
+		w    *OSMInterpolation
+	}{
+		{"odd", &OSMInterpolation{
+			Tags: OSMTags{"addr:interpolation": "odd", "addr:street": "Market Street", "addr:city": "San Francisco"},
+			From: OSMTags{"addr:housenumber": "1"},
+			To:   OSMTags{"addr:housenumber": "99"},
+		}},
+		{"all", &OSMInterpolation{
+			Tags: OSMTags{"addr:interpolation": "all", "addr:street": "Karl Johans gate"},
+			From: OSMTags{"addr:housenumber": "2"},
+			To:   OSMTags{"addr:housenumber": "10"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r := FromOSMInterpolation(tt.w)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromOSMInterpolation dropped %v", r.Dropped)
+			}
+			got, r, err := ToOSMInterpolation(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToOSMInterpolation dropped %v", r.Dropped)
+			}
+			if !reflect.DeepEqual(got, tt.w) {
+				t.Errorf("got %+v, want %+v", got, tt.w)
+			}
+		})
+	}
+}
+
+func TestOSMInterpolationReport(t *testing.T) {
+	w := &OSMInterpolation{
+		Tags: OSMTags{"addr:interpolation": "alphabetic", "addr:street": "Market Street", "addr:floor": "1"},
+		From: OSMTags{"addr:housenumber": "1a", "addr:street": "Market Street"},
+		To:   OSMTags{"addr:housenumber": "1f", "addr:street": "Mission Street"},
+	}
+	_, r := FromOSMInterpolation(w)
+	if got, want := strings.Join(r.Dropped, " "), "/tags/addr:floor /tags/addr:interpolation /to/addr:street"; got != want {
+		t.Errorf("FromOSMInterpolation dropped %q, want %q", got, want)
+	}
+
+	a, _ := FromOSM(OSMTags{"addr:street": "Market Street", "addr:housenumber": "1"})
+	if _, _, err := ToOSMInterpolation(a); !errors.Is(err, ErrNoNumberRange) {
+		t.Errorf("got error %v, want %v", err, ErrNoNumberRange)
+	}
+}
@@ -0,0 +1,596 @@
+package xal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Errors returned when applying or generating patches.
+var (
+	ErrInvalidPatch   = errors.New("xal: invalid patch")
+	ErrUnknownElement = errors.New("xal: unknown xAL element")
+	ErrPathNotFound   = errors.New("xal: path not found")
+	ErrTestFailed     = errors.New("xal: test operation failed")
+)
+
+// Operation - A single RFC 6902 JSON Patch operation.
+//
+// Paths are JSON Pointers (RFC 6901) built from the json tag names of the xAL types,
+// eg. /address_details/0/country/country_name_code/0/text
+type Operation struct {
+	Op    string          `json:"op"`             // add, remove, replace, move, copy or test
+	Path  string          `json:"path"`           // Target location of the operation
+	From  string          `json:"from,omitempty"` // Source location for move and copy
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Patch - An RFC 6902 JSON Patch document.
+type Patch []Operation
+
+// PatchError - Reports the operation of a patch that could not be applied.
+type PatchError struct {
+	Index int // Position of the operation in the patch
+	Op    string
+	Path  string
+	Err   error
+}
+
+func (e *PatchError) Error() string {
+	return fmt.Sprintf("%v (operation %d: %s %s)", e.Err, e.Index, e.Op, e.Path)
+}
+
+func (e *PatchError) Unwrap() error { return e.Err }
+
+// Apply applies the patch to doc, which must be a non-nil pointer to an xAL type such as *XAL or *AddressDetails.
+//
+// Every path is checked against the xAL model before it is applied,
+// so a path naming an element that does not exist in the spec fails with ErrUnknownElement.
+// The patch is atomic: if any operation fails, doc is left untouched.
+func (p Patch) Apply(doc any) error {
+	rv, err := patchTarget(doc)
+	if err != nil {
+		return err
+	}
+	root, err := toGeneric(doc)
+	if err != nil {
+		return err
+	}
+	for i, op := range p {
+		if root, err = op.apply(root, rv.Type()); err != nil {
+			return &PatchError{Index: i, Op: op.Op, Path: op.Path, Err: err}
+		}
+	}
+	return fromGeneric(root, rv)
+}
+
+// ApplyMergePatch applies an RFC 7396 JSON merge patch to doc,
+// which must be a non-nil pointer to an xAL type such as *XAL or *AddressDetails.
+//
+// Members of the patch that do not name an xAL element fail with ErrUnknownElement,
+// in which case doc is left untouched.
+func ApplyMergePatch(doc any, patch []byte) error {
+	rv, err := patchTarget(doc)
+	if err != nil {
+		return err
+	}
+	p, err := decodeGeneric(patch)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	if err := checkValue(rv.Type(), p); err != nil {
+		return err
+	}
+	root, err := toGeneric(doc)
+	if err != nil {
+		return err
+	}
+	return fromGeneric(mergePatch(root, p), rv)
+}
+
+// Diff returns the JSON Patch that transforms from into to.
+//
+// Both documents are compared through their json encoding,
+// so from and to are usually values of the same xAL type.
+func Diff(from, to any) (Patch, error) {
+	a, err := toGeneric(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := toGeneric(to)
+	if err != nil {
+		return nil, err
+	}
+	var p Patch
+	if err := diff(&p, "", a, b); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (op Operation) apply(root any, t reflect.Type) (any, error) {
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPath(t, path); err != nil {
+		return nil, err
+	}
+	switch op.Op {
+	case "add", "replace", "test":
+		v, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkValue(typeAt(t, path), v); err != nil {
+			return nil, err
+		}
+		switch op.Op {
+		case "add":
+			return addValue(root, path, v)
+		case "replace":
+			return replaceValue(root, path, v)
+		}
+		cur, err := getValue(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(cur, v) {
+			return nil, ErrTestFailed
+		}
+		return root, nil
+	case "remove":
+		root, _, err = removeValue(root, path)
+		return root, err
+	case "move", "copy":
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPath(t, from); err != nil {
+			return nil, err
+		}
+		if typeAt(t, from) != typeAt(t, path) {
+			return nil, fmt.Errorf("%w: cannot %s %s to %s", ErrInvalidPatch, op.Op, op.From, op.Path)
+		}
+		var v any
+		if op.Op == "move" {
+			if len(path) > len(from) && hasPrefix(path, from) {
+				return nil, fmt.Errorf("%w: cannot move %s into one of its children", ErrInvalidPatch, op.From)
+			}
+			if root, v, err = removeValue(root, from); err != nil {
+				return nil, err
+			}
+		} else {
+			if v, err = getValue(root, from); err != nil {
+				return nil, err
+			}
+			if v, err = cloneGeneric(v); err != nil {
+				return nil, err
+			}
+		}
+		return addValue(root, path, v)
+	}
+	return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidPatch, op.Op)
+}
+
+func (op Operation) value() (any, error) {
+	if op.Value == nil {
+		return nil, fmt.Errorf("%w: %s requires a value", ErrInvalidPatch, op.Op)
+	}
+	v, err := decodeGeneric(op.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	return v, nil
+}
+
+func patchTarget(doc any) (reflect.Value, error) {
+	rv := reflect.ValueOf(doc)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return rv, fmt.Errorf("%w: target must be a non-nil pointer, got %T", ErrInvalidPatch, doc)
+	}
+	return rv, nil
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped reference tokens.
+func parsePointer(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if s[0] != '/' {
+		return nil, fmt.Errorf("%w: pointer %q must start with /", ErrInvalidPatch, s)
+	}
+	tokens := strings.Split(s[1:], "/")
+	for i, tok := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(tok)
+	}
+	return tokens, nil
+}
+
+// escapePointerToken escapes a reference token for use in a JSON Pointer.
+func escapePointerToken(tok string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(tok)
+}
+
+func hasPrefix(path, prefix []string) bool {
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPath reports an error unless path names an element of the xAL type t.
+func checkPath(t reflect.Type, path []string) error {
+	for _, tok := range path {
+		t = indirectType(t)
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := fieldByJSONName(t, tok)
+			if !ok {
+				return fmt.Errorf("%w %q in %s", ErrUnknownElement, tok, t.Name())
+			}
+			t = f.Type
+		case reflect.Slice:
+			if tok != "-" && !isArrayIndex(tok) {
+				return fmt.Errorf("%w: %q is not an index of %s", ErrInvalidPatch, tok, t.Name())
+			}
+			t = t.Elem()
+		default:
+			return fmt.Errorf("%w %q: %s has no child elements", ErrUnknownElement, tok, t.Name())
+		}
+	}
+	return nil
+}
+
+// checkValue reports an error unless every object member of v names an element of the xAL type t.
+func checkValue(t reflect.Type, v any) error {
+	t = indirectType(t)
+	switch v := v.(type) {
+	case map[string]any:
+		if t.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: %s is not an object", ErrInvalidPatch, typeName(t))
+		}
+		for k, child := range v {
+			f, ok := fieldByJSONName(t, k)
+			if !ok {
+				return fmt.Errorf("%w %q in %s", ErrUnknownElement, k, t.Name())
+			}
+			if err := checkValue(f.Type, child); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if t.Kind() != reflect.Slice {
+			return fmt.Errorf("%w: %s is not an array", ErrInvalidPatch, typeName(t))
+		}
+		for _, child := range v {
+			if err := checkValue(t.Elem(), child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// typeAt returns the type of the element named by a path already accepted by checkPath.
+func typeAt(t reflect.Type, path []string) reflect.Type {
+	for _, tok := range path {
+		t = indirectType(t)
+		if t.Kind() == reflect.Slice {
+			t = t.Elem()
+			continue
+		}
+		f, _ := fieldByJSONName(t, tok)
+		t = f.Type
+	}
+	return indirectType(t)
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+func typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		return t.Name()
+	}
+	return t.String()
+}
+
+// fieldByJSONName returns the struct field whose json tag name is name.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if jsonName(f) == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// jsonName returns the name a struct field is encoded under, or "" if the field is not encoded.
+func jsonName(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	}
+	return name
+}
+
+func isArrayIndex(tok string) bool {
+	if tok == "" || (len(tok) > 1 && tok[0] == '0') {
+		return false
+	}
+	for _, r := range tok {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func arrayIndex(tok string, n int) (int, error) {
+	i, err := strconv.Atoi(tok)
+	if err != nil || !isArrayIndex(tok) || i >= n {
+		return 0, fmt.Errorf("%w: index %s out of range", ErrPathNotFound, tok)
+	}
+	return i, nil
+}
+
+func toGeneric(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeGeneric(b)
+}
+
+func decodeGeneric(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func cloneGeneric(v any) (any, error) {
+	return toGeneric(v)
+}
+
+// fromGeneric decodes a generic JSON value into the value rv points to.
+func fromGeneric(v any, rv reflect.Value) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	out := reflect.New(rv.Elem().Type())
+	if err := dec.Decode(out.Interface()); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	rv.Elem().Set(out.Elem())
+	return nil
+}
+
+func getValue(node any, path []string) (any, error) {
+	for _, tok := range path {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[tok]
+			if !ok {
+				return nil, fmt.Errorf("%w: no member %q", ErrPathNotFound, tok)
+			}
+			node = child
+		case []any:
+			i, err := arrayIndex(tok, len(n))
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("%w: %q has no parent", ErrPathNotFound, tok)
+		}
+	}
+	return node, nil
+}
+
+func addValue(node any, path []string, v any) (any, error) {
+	if len(path) == 0 {
+		return v, nil
+	}
+	tok, rest := path[0], path[1:]
+	switch n := node.(type) {
+	case map[string]any:
+		if len(rest) == 0 {
+			n[tok] = v
+			return n, nil
+		}
+		child, ok := n[tok]
+		if !ok {
+			return nil, fmt.Errorf("%w: no member %q", ErrPathNotFound, tok)
+		}
+		child, err := addValue(child, rest, v)
+		n[tok] = child
+		return n, err
+	case []any:
+		if len(rest) == 0 {
+			if tok == "-" {
+				return append(n, v), nil
+			}
+			i, err := arrayIndex(tok, len(n)+1)
+			if err != nil {
+				return nil, err
+			}
+			n = append(n, nil)
+			copy(n[i+1:], n[i:])
+			n[i] = v
+			return n, nil
+		}
+		i, err := arrayIndex(tok, len(n))
+		if err != nil {
+			return nil, err
+		}
+		child, err := addValue(n[i], rest, v)
+		n[i] = child
+		return n, err
+	}
+	return nil, fmt.Errorf("%w: %q has no parent", ErrPathNotFound, tok)
+}
+
+func replaceValue(node any, path []string, v any) (any, error) {
+	if _, err := getValue(node, path); err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return v, nil
+	}
+	parent, _ := getValue(node, path[:len(path)-1])
+	switch p := parent.(type) {
+	case map[string]any:
+		p[path[len(path)-1]] = v
+	case []any:
+		i, _ := strconv.Atoi(path[len(path)-1])
+		p[i] = v
+	}
+	return node, nil
+}
+
+func removeValue(node any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("%w: cannot remove the document root", ErrInvalidPatch)
+	}
+	tok, rest := path[0], path[1:]
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[tok]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: no member %q", ErrPathNotFound, tok)
+		}
+		if len(rest) == 0 {
+			delete(n, tok)
+			return n, child, nil
+		}
+		child, removed, err := removeValue(child, rest)
+		n[tok] = child
+		return n, removed, err
+	case []any:
+		i, err := arrayIndex(tok, len(n))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rest) == 0 {
+			removed := n[i]
+			return append(n[:i], n[i+1:]...), removed, nil
+		}
+		child, removed, err := removeValue(n[i], rest)
+		n[i] = child
+		return n, removed, err
+	}
+	return nil, nil, fmt.Errorf("%w: %q has no parent", ErrPathNotFound, tok)
+}
+
+// mergePatch implements the MergePatch algorithm of RFC 7396.
+func mergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
+
+func diff(p *Patch, path string, a, b any) error {
+	switch a := a.(type) {
+	case map[string]any:
+		if b, ok := b.(map[string]any); ok {
+			for _, k := range sortedKeys(a) {
+				child := path + "/" + escapePointerToken(k)
+				if bv, ok := b[k]; ok {
+					if err := diff(p, child, a[k], bv); err != nil {
+						return err
+					}
+					continue
+				}
+				*p = append(*p, Operation{Op: "remove", Path: child})
+			}
+			for _, k := range sortedKeys(b) {
+				if _, ok := a[k]; !ok {
+					if err := p.add("add", path+"/"+escapePointerToken(k), b[k]); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+	case []any:
+		if b, ok := b.([]any); ok {
+			n := min(len(a), len(b))
+			for i := 0; i < n; i++ {
+				if err := diff(p, path+"/"+strconv.Itoa(i), a[i], b[i]); err != nil {
+					return err
+				}
+			}
+			for i := len(a) - 1; i >= n; i-- {
+				*p = append(*p, Operation{Op: "remove", Path: path + "/" + strconv.Itoa(i)})
+			}
+			for i := n; i < len(b); i++ {
+				if err := p.add("add", path+"/"+strconv.Itoa(i), b[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return p.add("replace", path, b)
+}
+
+func (p *Patch) add(op, path string, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	*p = append(*p, Operation{Op: op, Path: path, Value: raw})
+	return nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
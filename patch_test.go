@@ -0,0 +1,158 @@
+package xal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// The patch vectors follow the examples of RFC 6902 appendix A and RFC 7396 appendix A,
+// on the members of an AddressDetails rather than on arbitrary JSON.
+
+func decodeAddress(t *testing.T, doc string) *AddressDetails {
+	t.Helper()
+	var a AddressDetails
+	if err := json.Unmarshal([]byte(doc), &a); err != nil {
+		t.Fatal(err)
+	}
+	return &a
+}
+
+func sameAddress(t *testing.T, got *AddressDetails, want string) {
+	t.Helper()
+	g, _ := json.Marshal(got)
+	w, _ := json.Marshal(decodeAddress(t, want))
+	if string(g) != string(w) {
+		t.Errorf("got %s\nwant %s", g, w)
+	}
+}
+
+func TestPatchApply(t *testing.T) {
+	const doc = `{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}]}`
+	tests := []struct {
+		name  string
+		patch string
+		want  string // document after the patch, or "" if the patch fails with err
+		err   error
+	}{
+		{"A.1 adding an object member", `[{"op":"add","path":"/attr_address_type","value":"Postal"}]`,
+			`{"attr_address_type":"Postal","attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"A.2 adding an array element", `[{"op":"add","path":"/address_lines/1","value":{"text":"x"}}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"x"},{"text":"b"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"adding to the end of an array", `[{"op":"add","path":"/address_lines/-","value":{"text":"x"}}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"},{"text":"x"}]}`, nil},
+		{"A.3 removing an object member", `[{"op":"remove","path":"/attr_usage"}]`,
+			`{"address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"A.4 removing an array element", `[{"op":"remove","path":"/address_lines/1"}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"A.5 replacing a value", `[{"op":"replace","path":"/attr_usage","value":"Home"}]`,
+			`{"attr_usage":"Home","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"A.6 moving a value", `[{"op":"move","from":"/attr_usage","path":"/attr_current_status"}]`,
+			`{"attr_current_status":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"A.7 moving an array element", `[{"op":"move","from":"/address_lines/1","path":"/address_lines/3"}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"c"},{"text":"d"},{"text":"b"}]}`, nil},
+		{"copying a value", `[{"op":"copy","from":"/address_lines/0/text","path":"/address_lines/3/text"}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"a"}]}`, nil},
+		{"A.8 testing a value: success", `[{"op":"test","path":"/attr_usage","value":"Business"},{"op":"test","path":"/address_lines/1","value":{"text":"b"}}]`,
+			doc, nil},
+		{"A.9 testing a value: error", `[{"op":"test","path":"/attr_usage","value":"Home"}]`, "", ErrTestFailed},
+		{"A.10 adding a nested member object", `[{"op":"add","path":"/country","value":{"country_name_code":[{"text":"US"}]}}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}],"country":{"country_name_code":[{"text":"US"}]}}`, nil},
+		{"A.11 ignoring unrecognized elements", `[{"op":"add","path":"/attr_address_type","value":"Postal","xyz":123}]`,
+			`{"attr_address_type":"Postal","attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"},{"text":"d"}]}`, nil},
+		{"A.12 adding to a nonexistent target", `[{"op":"add","path":"/country/country_name","value":[{"text":"France"}]}]`, "", ErrPathNotFound},
+		{"A.15 comparing strings and numbers", `[{"op":"test","path":"/attr_usage","value":10}]`, "", ErrTestFailed},
+		{"A.16 adding an array value", `[{"op":"replace","path":"/address_lines","value":[{"text":"x"},{"text":"y"}]}]`,
+			`{"attr_usage":"Business","address_lines":[{"text":"x"},{"text":"y"}]}`, nil},
+		{"unknown element", `[{"op":"add","path":"/baz","value":"qux"}]`, "", ErrUnknownElement},
+		{"unknown member of a value", `[{"op":"add","path":"/country","value":{"baz":"qux"}}]`, "", ErrUnknownElement},
+		{"index out of range", `[{"op":"remove","path":"/address_lines/4"}]`, "", ErrPathNotFound},
+		{"leading zero index", `[{"op":"remove","path":"/address_lines/01"}]`, "", ErrInvalidPatch},
+		{"move into a child", `[{"op":"move","from":"/address_lines","path":"/address_lines/0"}]`, "", ErrInvalidPatch},
+		{"missing value", `[{"op":"add","path":"/attr_usage"}]`, "", ErrInvalidPatch},
+		{"unknown operation", `[{"op":"merge","path":"/attr_usage","value":"Home"}]`, "", ErrInvalidPatch},
+		{"atomic", `[{"op":"replace","path":"/attr_usage","value":"Home"},{"op":"test","path":"/attr_usage","value":"Business"}]`, "", ErrTestFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Patch
+			if err := json.Unmarshal([]byte(tt.patch), &p); err != nil {
+				t.Fatal(err)
+			}
+			a := decodeAddress(t, doc)
+			err := p.Apply(a)
+			if tt.err != nil {
+				var pe *PatchError
+				if !errors.Is(err, tt.err) || !errors.As(err, &pe) {
+					t.Fatalf("Apply() error = %v, want a *PatchError wrapping %v", err, tt.err)
+				}
+				sameAddress(t, a, doc)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			sameAddress(t, a, tt.want)
+		})
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc, patch string
+		want       string
+		err        error
+	}{
+		{"replacing a member", `{"attr_usage":"Business"}`, `{"attr_usage":"Home"}`, `{"attr_usage":"Home"}`, nil},
+		{"adding a member", `{"attr_usage":"Business"}`, `{"attr_address_type":"Postal"}`, `{"attr_usage":"Business","attr_address_type":"Postal"}`, nil},
+		{"removing a member", `{"attr_usage":"Business","attr_address_type":"Postal"}`, `{"attr_usage":null}`, `{"attr_address_type":"Postal"}`, nil},
+		{"removing a missing member", `{"attr_usage":"Business"}`, `{"attr_address_type":null}`, `{"attr_usage":"Business"}`, nil},
+		{"replacing an array", `{"address_lines":[{"text":"a"},{"text":"b"}]}`, `{"address_lines":[{"text":"c"}]}`, `{"address_lines":[{"text":"c"}]}`, nil},
+		{"merging nested objects", `{"country":{"country_name_code":[{"text":"US"}],"country_name":[{"text":"USA"}]}}`,
+			`{"country":{"country_name":null,"locality":{"locality_name":[{"text":"Oslo"}]}}}`,
+			`{"country":{"country_name_code":[{"text":"US"}],"locality":{"locality_name":[{"text":"Oslo"}]}}}`, nil},
+		{"adding an object to a missing member", `{}`, `{"country":{"country_name_code":[{"text":"NO"}]}}`,
+			`{"country":{"country_name_code":[{"text":"NO"}]}}`, nil},
+		{"unknown member", `{"attr_usage":"Business"}`, `{"baz":"qux"}`, "", ErrUnknownElement},
+		{"unknown nested member", `{"attr_usage":"Business"}`, `{"country":{"baz":null}}`, "", ErrUnknownElement},
+		{"invalid JSON", `{"attr_usage":"Business"}`, `{`, "", ErrInvalidPatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := decodeAddress(t, tt.doc)
+			err := ApplyMergePatch(a, []byte(tt.patch))
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("ApplyMergePatch() error = %v, want %v", err, tt.err)
+				}
+				sameAddress(t, a, tt.doc)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			sameAddress(t, a, tt.want)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	from := decodeAddress(t, `{"attr_usage":"Business","address_lines":[{"text":"a"},{"text":"b"},{"text":"c"}],"country":{"country_name_code":[{"text":"US"}]}}`)
+	to := decodeAddress(t, `{"attr_address_type":"Postal","address_lines":[{"text":"a"},{"text":"x"}],"country":{"country_name_code":[{"text":"US"}]}}`)
+	p, err := Diff(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := json.Marshal(p)
+	want := `[{"op":"replace","path":"/address_lines/1/text","value":"x"},{"op":"remove","path":"/address_lines/2"},` +
+		`{"op":"remove","path":"/attr_usage"},{"op":"add","path":"/attr_address_type","value":"Postal"}]`
+	if string(got) != want {
+		t.Errorf("Diff() = %s\nwant %s", got, want)
+	}
+	if err := p.Apply(from); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(to)
+	sameAddress(t, from, string(b))
+}
@@ -0,0 +1,212 @@
+package xal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// ErrStagePanic is wrapped by the StageError of a stage that panicked.
+var ErrStagePanic = errors.New("xal: pipeline stage panicked")
+
+// Stage - A step of a Pipeline, eg. parsing, normalisation, validation or enrichment.
+//
+// A stage returns the address to pass to the next stage: a itself, modified in place, or a new address.
+// Returning a nil address and no error filters the address out. Stages run concurrently on different
+// addresses, and should return early once ctx is done.
+type Stage func(ctx context.Context, a *AddressDetails) (*AddressDetails, error)
+
+// ValidateStage is a Stage failing on the addresses that do not validate against the profile
+// of their country, see ValidateProfile.
+func ValidateStage(_ context.Context, a *AddressDetails) (*AddressDetails, error) {
+	return a, ValidateProfile(a)
+}
+
+// Pipeline - Runs addresses through a list of stages on a pool of workers, emitting the results in input order.
+type Pipeline struct {
+	Stages  []Stage
+	Workers int // Number of addresses processed concurrently
+}
+
+// NewPipeline returns a Pipeline of the stages, with one worker per CPU.
+func NewPipeline(stages ...Stage) *Pipeline {
+	return &Pipeline{Stages: stages, Workers: runtime.GOMAXPROCS(0)}
+}
+
+// Result - The outcome of an address run through a Pipeline.
+type Result struct {
+	Index   int             // Position of the address in the input, counting from 0
+	Address *AddressDetails // Output of the last stage, nil if filtered out; on error, the input of the failed stage
+	Err     error           // A *StageError, the error of the input, or the cause of the cancellation of the run
+}
+
+// StageError - Reports the stage of a Pipeline that failed on an address.
+type StageError struct {
+	Index int // Position of the address in the input
+	Stage int // Position of the stage in the pipeline
+	Err   error
+}
+
+func (e *StageError) Error() string {
+	return fmt.Sprintf("%v (address %d, stage %d)", e.Err, e.Index, e.Stage)
+}
+
+func (e *StageError) Unwrap() error { return e.Err }
+
+// PipelineErrors - The errors of the addresses of a Pipeline run, in input order.
+type PipelineErrors []*StageError
+
+func (errs PipelineErrors) Error() string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+	return fmt.Sprintf("%v, and %d more errors", errs[0], len(errs)-1)
+}
+
+// Run returns an iterator over the results of running the addresses of in through the stages, eg.
+//
+//	for r := range p.Run(ctx, NewJSONDecoder(f).Addresses()) { ... }
+//
+// Addresses are read from in as workers free up, so that at most about twice as many addresses as there are
+// workers are held at any time, and the results are yielded in input order. An error of in is yielded as
+// the result of its position. A failed address does not stop the run, and its result holds a *StageError.
+//
+// When ctx is done, the run stops and a last result holds the cause of the cancellation. Breaking out of
+// the loop also stops the run. In both cases, Run waits for the stages in progress to return.
+func (p *Pipeline) Run(ctx context.Context, in iter.Seq2[*AddressDetails, error]) iter.Seq[Result] {
+	return func(yield func(Result) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		workers := max(p.Workers, 1)
+		type job struct {
+			index int
+			a     *AddressDetails
+			done  chan Result
+		}
+		jobs := make(chan *job)
+		order := make(chan *job, workers)
+		var wg sync.WaitGroup
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(order)
+			defer close(jobs)
+			i := 0
+			for a, err := range in {
+				j := &job{index: i, a: a, done: make(chan Result, 1)}
+				i++
+				select {
+				case order <- j:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					j.done <- Result{Index: j.index, Err: err}
+					continue
+				}
+				select {
+				case jobs <- j:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range jobs {
+					j.done <- p.run(ctx, j.index, j.a)
+				}
+			}()
+		}
+
+		next := 0 // Index of the next result
+		for j := range order {
+			select {
+			case r := <-j.done:
+				if ctx.Err() != nil {
+					// The stages in progress may have failed on the cancellation: report it instead.
+					yield(Result{Index: j.index, Err: context.Cause(ctx)})
+					return
+				}
+				if !yield(r) {
+					return
+				}
+				next++
+			case <-ctx.Done():
+				yield(Result{Index: j.index, Err: context.Cause(ctx)})
+				return
+			}
+		}
+		if err := context.Cause(ctx); err != nil {
+			yield(Result{Index: next, Err: err})
+		}
+	}
+}
+
+// Process runs the addresses through the stages, see Run, and returns the outputs in input order,
+// with nil for the addresses that failed or were filtered out.
+//
+// The error is nil, a PipelineErrors listing the failed addresses, or the cause of the cancellation of ctx.
+func (p *Pipeline) Process(ctx context.Context, addrs []*AddressDetails) ([]*AddressDetails, error) {
+	in := func(yield func(*AddressDetails, error) bool) {
+		for _, a := range addrs {
+			if !yield(a, nil) {
+				return
+			}
+		}
+	}
+	out := make([]*AddressDetails, len(addrs))
+	var errs PipelineErrors
+	for r := range p.Run(ctx, in) {
+		var se *StageError
+		switch {
+		case r.Err == nil:
+			out[r.Index] = r.Address
+		case errors.As(r.Err, &se):
+			errs = append(errs, se)
+		default:
+			return out, r.Err
+		}
+	}
+	if len(errs) > 0 {
+		return out, errs
+	}
+	return out, nil
+}
+
+// run runs the address through the stages.
+func (p *Pipeline) run(ctx context.Context, index int, a *AddressDetails) Result {
+	for i, stage := range p.Stages {
+		if ctx.Err() != nil {
+			return Result{Index: index, Address: a, Err: &StageError{Index: index, Stage: i, Err: context.Cause(ctx)}}
+		}
+		next, err := runStage(ctx, stage, a)
+		if err != nil {
+			return Result{Index: index, Address: a, Err: &StageError{Index: index, Stage: i, Err: err}}
+		}
+		if next == nil {
+			return Result{Index: index}
+		}
+		a = next
+	}
+	return Result{Index: index, Address: a}
+}
+
+// runStage runs the stage, turning a panic into an error wrapping ErrStagePanic.
+func runStage(ctx context.Context, stage Stage, a *AddressDetails) (next *AddressDetails, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			next, err = nil, fmt.Errorf("%w: %v", ErrStagePanic, v)
+		}
+	}()
+	return stage(ctx, a)
+}
@@ -0,0 +1,163 @@
+package xal
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// numbered returns n addresses whose locality is their index.
+func numbered(n int) []*AddressDetails {
+	addrs := make([]*AddressDetails, n)
+	for i := range addrs {
+		addrs[i] = &AddressDetails{}
+		addrs[i].SetLocalityName(strconv.Itoa(i))
+	}
+	return addrs
+}
+
+func index(a *AddressDetails) int {
+	i, _ := strconv.Atoi(a.LocalityName())
+	return i
+}
+
+func TestPipelineProcess(t *testing.T) {
+	const n = 40
+	// The first addresses are the slowest, so that results are produced out of order.
+	slow := func(_ context.Context, a *AddressDetails) (*AddressDetails, error) {
+		time.Sleep(time.Duration(n-index(a)) * 100 * time.Microsecond)
+		return a, nil
+	}
+	boom := errors.New("boom")
+	filter := func(_ context.Context, a *AddressDetails) (*AddressDetails, error) {
+		switch i := index(a); {
+		case i%10 == 3:
+			return nil, boom
+		case i%10 == 5:
+			panic("stage bug")
+		case i%2 == 0:
+			return nil, nil
+		}
+		return a, nil
+	}
+	p := &Pipeline{Stages: []Stage{slow, filter}, Workers: 4}
+	out, err := p.Process(context.Background(), numbered(n))
+
+	var errs PipelineErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Process() error = %v, want PipelineErrors", err)
+	}
+	var want []int
+	for i := 3; i < n; i += 10 {
+		want = append(want, i, i+2)
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Process() failed on %d addresses, want %d: %v", len(errs), len(want), errs)
+	}
+	for k, se := range errs {
+		if se.Index != want[k] || se.Stage != 1 {
+			t.Errorf("error %d on address %d, stage %d, want address %d, stage 1", k, se.Index, se.Stage, want[k])
+		}
+		if wantErr := map[bool]error{true: boom, false: ErrStagePanic}[se.Index%10 == 3]; !errors.Is(se, wantErr) {
+			t.Errorf("error on address %d = %v, want %v", se.Index, se.Err, wantErr)
+		}
+	}
+	for i, a := range out {
+		switch {
+		case i%2 == 0 || i%10 == 3 || i%10 == 5:
+			if a != nil {
+				t.Errorf("address %d = %s, want nil", i, a.LocalityName())
+			}
+		case a == nil || index(a) != i:
+			t.Errorf("address %d out of order: %v", i, a)
+		}
+	}
+}
+
+func TestPipelineRunInputError(t *testing.T) {
+	bad := errors.New("bad record")
+	in := func(yield func(*AddressDetails, error) bool) {
+		for i, a := range numbered(3) {
+			var err error
+			if i == 1 {
+				a, err = nil, bad
+			}
+			if !yield(a, err) {
+				return
+			}
+		}
+	}
+	var got []Result
+	for r := range NewPipeline().Run(context.Background(), in) {
+		got = append(got, r)
+	}
+	if len(got) != 3 || got[0].Err != nil || got[1].Err != bad || got[2].Err != nil {
+		t.Fatalf("Run() = %+v, want the error of the input at index 1", got)
+	}
+	for i, r := range got {
+		if r.Index != i {
+			t.Errorf("result %d has index %d", i, r.Index)
+		}
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	stop := errors.New("stopped")
+	block := func(ctx context.Context, a *AddressDetails) (*AddressDetails, error) {
+		if index(a) == 0 {
+			return a, nil
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	p := &Pipeline{Stages: []Stage{block}, Workers: 2}
+	var got []Result
+	for r := range p.Run(ctx, func(yield func(*AddressDetails, error) bool) {
+		for _, a := range numbered(100) {
+			if !yield(a, nil) {
+				return
+			}
+		}
+	}) {
+		got = append(got, r)
+		if len(got) == 1 {
+			cancel(stop)
+		}
+	}
+	if len(got) != 2 || got[0].Err != nil || !errors.Is(got[1].Err, stop) || got[1].Index != 1 {
+		t.Fatalf("Run() = %+v, want the first address, then the cause of the cancellation", got)
+	}
+
+	_, err := p.Process(ctx, numbered(3))
+	if !errors.Is(err, stop) {
+		t.Errorf("Process() error = %v, want %v", err, stop)
+	}
+}
+
+func TestPipelineBreak(t *testing.T) {
+	stages := 0
+	count := func(_ context.Context, a *AddressDetails) (*AddressDetails, error) {
+		stages++
+		return a, nil
+	}
+	p := &Pipeline{Stages: []Stage{count}, Workers: 1}
+	for r := range p.Run(context.Background(), func(yield func(*AddressDetails, error) bool) {
+		for _, a := range numbered(1000) {
+			if !yield(a, nil) {
+				return
+			}
+		}
+	}) {
+		if r.Index == 2 {
+			break
+		}
+	}
+	// The run stops with the loop: at most the workers and the order buffer are ahead of it.
+	if stages > 10 {
+		t.Errorf("%d addresses were processed after breaking out of the loop at the third", stages)
+	}
+}
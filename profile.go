@@ -0,0 +1,280 @@
+package xal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Profile - The subset of xAL used by the postal system of a country.
+//
+// A profile builds addresses from the fields native to the country, eg. post_town in GB or chome in JP,
+// and validates addresses against the components the country requires or forbids.
+type Profile struct {
+	Country   string      // ISO 3166-1 alpha-2 code, eg. GB
+	Fields    []string    // Country-native fields accepted by Build
+	Required  []Component // Components every address of the country carries
+	Forbidden []Component // Components the postal system of the country does not use
+
+	// Builder places the country-native fields on an address.
+	// It is only called with fields listed in Fields.
+	Builder func(fields map[string]string) *AddressBuilder
+}
+
+var (
+	profilesMu sync.RWMutex
+	profiles   = map[string]*Profile{}
+)
+
+// RegisterProfile makes a profile available by its country code, replacing any profile registered for that country.
+func RegisterProfile(p *Profile) {
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+	profiles[strings.ToUpper(p.Country)] = p
+}
+
+// LookupProfile returns the profile registered for an ISO 3166-1 alpha-2 country code.
+func LookupProfile(country string) (*Profile, bool) {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+	p, ok := profiles[strings.ToUpper(country)]
+	return p, ok
+}
+
+// Profiles returns the country codes of the registered profiles, sorted.
+func Profiles() []string {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+	codes := make([]string, 0, len(profiles))
+	for code := range profiles {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Build builds an address from country-native fields, and validates it against the profile.
+// Empty fields are ignored.
+func (p *Profile) Build(fields map[string]string) (*AddressDetails, error) {
+	var errs ValidationErrors
+	known := map[string]bool{}
+	for _, f := range p.Fields {
+		known[f] = true
+	}
+	for _, f := range sortedFieldNames(fields) {
+		if !known[f] {
+			errs = append(errs, &ValidationError{Field: f, Reason: fmt.Sprintf("unknown field for %s addresses", p.Country)})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	a, err := p.Builder(fields).Country(p.Country).Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Validate(a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Validate checks that the address belongs to the country of the profile, carries all of its
+// required components and none of its forbidden ones, and satisfies the constraints of the spec.
+func (p *Profile) Validate(a *AddressDetails) error {
+	var errs ValidationErrors
+	if code := a.CountryCode(); !strings.EqualFold(code, p.Country) {
+		errs = append(errs, &ValidationError{Field: string(ComponentCountryCode), Reason: fmt.Sprintf("country code %q is not %s", code, p.Country)})
+	}
+	for _, c := range p.Required {
+		if a.component(c) == "" {
+			errs = append(errs, &ValidationError{Field: string(c), Reason: fmt.Sprintf("required in %s addresses", p.Country)})
+		}
+	}
+	for _, c := range p.Forbidden {
+		if a.component(c) != "" {
+			errs = append(errs, &ValidationError{Field: string(c), Reason: fmt.Sprintf("not used in %s addresses", p.Country)})
+		}
+	}
+	if err := a.Validate(); err != nil {
+		errs = append(errs, err.(ValidationErrors)...)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateProfile validates the address against the profile of its country code.
+// Addresses of countries without a profile are only validated against the spec.
+func ValidateProfile(a *AddressDetails) error {
+	if p, ok := LookupProfile(a.CountryCode()); ok {
+		return p.Validate(a)
+	}
+	return a.Validate()
+}
+
+func sortedFieldNames(fields map[string]string) []string {
+	names := make([]string, 0, len(fields))
+	for f := range fields {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// with calls set with the value of the field when it is not empty.
+func with(fields map[string]string, field string, set func(v string)) {
+	if v := strings.TrimSpace(fields[field]); v != "" {
+		set(v)
+	}
+}
+
+func init() {
+	RegisterProfile(&Profile{
+		Country:   "US",
+		Fields:    []string{"street", "street_suffix", "number", "unit_type", "unit", "city", "state", "zip"},
+		Required:  []Component{ComponentAdminArea, ComponentLocality, ComponentPostalCode},
+		Forbidden: []Component{ComponentDependentStreetName, ComponentPostOffice},
+		Builder: func(f map[string]string) *AddressBuilder {
+			b := NewAddress()
+			with(f, "state", func(v string) { b.AdminArea(v) })
+			with(f, "city", func(v string) { b.Locality(v) })
+			with(f, "street", func(v string) { b.Street(v, f["street_suffix"]) })
+			with(f, "number", func(v string) { b.Number(v) })
+			with(f, "unit", func(v string) { b.Unit(f["unit_type"], v) })
+			with(f, "zip", func(v string) { b.PostalCode(v) })
+			return b
+		},
+	})
+
+	// Royal Mail Postcode Address File (PAF) fields.
+	RegisterProfile(&Profile{
+		Country: "GB",
+		Fields: []string{
+			"sub_building", "building_name", "building_number",
+			"dependent_thoroughfare", "thoroughfare",
+			"double_dependent_locality", "dependent_locality",
+			"post_town", "postcode",
+		},
+		Required:  []Component{ComponentPostTown, ComponentPostalCode},
+		Forbidden: []Component{ComponentPostOffice},
+		Builder: func(f map[string]string) *AddressBuilder {
+			b := NewAddress()
+			with(f, "post_town", func(v string) {
+				b.set.postalCode().PostTown = &PostTown{PostTownName: []*PostTownName{{Text: v}}}
+			})
+			with(f, "dependent_locality", func(v string) { b.DependentLocality(v) })
+			with(f, "double_dependent_locality", func(v string) {
+				l := b.set.locality()
+				if l.DependentLocality == nil {
+					l.DependentLocality = &DependentLocality{}
+				}
+				l.DependentLocality.DependentLocality = &DependentLocality{
+					DependentLocalityName: []*DependentLocalityName{{Text: v}},
+				}
+			})
+			with(f, "thoroughfare", func(v string) { b.Street(v, "") })
+			with(f, "dependent_thoroughfare", func(v string) {
+				b.set.thoroughfare().DependentThoroughfare = &DependentThoroughfare{ThoroughfareName: []*ThoroughfareName{{Text: v}}}
+			})
+			with(f, "building_number", func(v string) { b.Number(v) })
+			with(f, "building_name", func(v string) { b.set.premise().PremiseName = []*PremiseName{{Text: v}} })
+			with(f, "sub_building", func(v string) {
+				p := b.set.premise()
+				p.SubPremise = append(p.SubPremise, &SubPremise{SubPremiseName: []*SubPremiseName{{Text: v}}})
+			})
+			with(f, "postcode", func(v string) { b.PostalCode(v) })
+			return b
+		},
+	})
+
+	// Japanese addresses are made of blocks rather than streets:
+	// the chōme is a numbered subdivision of the district, the banchi a block and the gō a building.
+	RegisterProfile(&Profile{
+		Country:   "JP",
+		Fields:    []string{"postal_code", "prefecture", "city", "district", "chome", "banchi", "go", "building", "room"},
+		Required:  []Component{ComponentAdminArea, ComponentLocality, ComponentPostalCode},
+		Forbidden: []Component{ComponentDependentStreetName, ComponentPostBox},
+		Builder: func(f map[string]string) *AddressBuilder {
+			b := NewAddress()
+			with(f, "prefecture", func(v string) { b.AdminArea(v) })
+			with(f, "city", func(v string) { b.Locality(v) })
+			with(f, "district", func(v string) { b.DependentLocality(v) })
+			with(f, "chome", func(v string) {
+				l := b.set.locality()
+				if l.DependentLocality == nil {
+					l.DependentLocality = &DependentLocality{}
+				}
+				l.DependentLocality.DependentLocalityNumber = &DependentLocalityNumber{
+					AttrNameNumberOccurrence: "After",
+					Text:                     v,
+				}
+			})
+			premise := func() *Premise {
+				l := b.set.locality()
+				if l.DependentLocality == nil {
+					l.DependentLocality = &DependentLocality{}
+				}
+				if l.DependentLocality.Premise == nil {
+					l.DependentLocality.Premise = &Premise{}
+				}
+				return l.DependentLocality.Premise
+			}
+			with(f, "banchi", func(v string) { premise().PremiseNumber = []*PremiseNumber{{AttrType: "Banchi", Text: v}} })
+			with(f, "go", func(v string) {
+				premise().Premise = &Premise{PremiseNumber: []*PremiseNumber{{AttrType: "Go", Text: v}}}
+			})
+			with(f, "building", func(v string) { premise().BuildingName = []*BuildingName{{Text: v}} })
+			with(f, "room", func(v string) {
+				p := premise()
+				p.SubPremise = append(p.SubPremise, &SubPremise{SubPremiseNumber: []*SubPremiseNumber{{Text: v}}})
+			})
+			with(f, "postal_code", func(v string) { b.PostalCode(v) })
+			return b
+		},
+	})
+
+	// Indian addresses name the delivering post office, eg. Kottivakkam (P.O), next to the PIN code.
+	RegisterProfile(&Profile{
+		Country:   "IN",
+		Fields:    []string{"house_number", "street", "area", "post_office", "city", "state", "pin"},
+		Required:  []Component{ComponentAdminArea, ComponentLocality, ComponentPostalCode},
+		Forbidden: []Component{ComponentDependentStreetName},
+		Builder: func(f map[string]string) *AddressBuilder {
+			b := NewAddress()
+			with(f, "state", func(v string) { b.AdminArea(v) })
+			with(f, "city", func(v string) { b.Locality(v) })
+			with(f, "area", func(v string) { b.DependentLocality(v) })
+			with(f, "street", func(v string) { b.Street(v, "") })
+			with(f, "house_number", func(v string) { b.Number(v) })
+			with(f, "post_office", func(v string) {
+				po := &PostOffice{AttrIndicator: "(P.O)", PostOfficeName: []*PostOfficeName{{Text: v}}}
+				if l := b.set.locality(); l.DependentLocality != nil {
+					l.DependentLocality.PostOffice = po
+				} else {
+					l.PostOffice = po
+				}
+			})
+			with(f, "pin", func(v string) { b.PostalCode(v) })
+			return b
+		},
+	})
+
+	RegisterProfile(&Profile{
+		Country:   "DE",
+		Fields:    []string{"street", "house_number", "postal_code", "city", "district"},
+		Required:  []Component{ComponentLocality, ComponentPostalCode},
+		Forbidden: []Component{ComponentDependentStreetName, ComponentPostOffice},
+		Builder: func(f map[string]string) *AddressBuilder {
+			b := NewAddress()
+			with(f, "city", func(v string) { b.Locality(v) })
+			with(f, "district", func(v string) { b.DependentLocality(v) })
+			with(f, "street", func(v string) { b.Street(v, "") })
+			with(f, "house_number", func(v string) { b.Number(v) })
+			with(f, "postal_code", func(v string) { b.PostalCode(v) })
+			return b
+		},
+	})
+}
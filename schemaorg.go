@@ -0,0 +1,122 @@
+package xal
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// SchemaOrgContext is the @context of schema.org JSON-LD documents.
+const SchemaOrgContext = "https://schema.org"
+
+// SchemaOrgAddress - A schema.org PostalAddress, as embedded in web pages in JSON-LD, eg.
+//
+//	{"@context": "https://schema.org", "@type": "PostalAddress", "streetAddress": "1 Market St, Apt 4",
+//	 "addressLocality": "San Francisco", "addressRegion": "CA", "postalCode": "94105", "addressCountry": "US"}
+//
+// Decoding also accepts an addressCountry given as a schema.org Country, eg. {"@type": "Country", "name": "US"}.
+type SchemaOrgAddress struct {
+	Context             string `json:"@context,omitempty"`
+	Type                string `json:"@type"`                         // PostalAddress
+	StreetAddress       string `json:"streetAddress,omitempty"`       // Street, house number and unit, separated by ", "
+	PostOfficeBoxNumber string `json:"postOfficeBoxNumber,omitempty"` // eg. 1234
+	AddressLocality     string `json:"addressLocality,omitempty"`     // eg. San Francisco
+	AddressRegion       string `json:"addressRegion,omitempty"`       // eg. CA
+	PostalCode          string `json:"postalCode,omitempty"`          // eg. 94105
+	AddressCountry      string `json:"addressCountry,omitempty"`      // ISO 3166-1 alpha-2 code, or name of the country
+}
+
+func (s *SchemaOrgAddress) UnmarshalJSON(data []byte) error {
+	type plain SchemaOrgAddress
+	var v struct {
+		plain
+		AddressCountry json.RawMessage `json:"addressCountry"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = SchemaOrgAddress(v.plain)
+	if len(v.AddressCountry) == 0 || string(v.AddressCountry) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(v.AddressCountry, &s.AddressCountry); err == nil {
+		return nil
+	}
+	var country struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(v.AddressCountry, &country); err != nil {
+		return err
+	}
+	s.AddressCountry = country.Name
+	return nil
+}
+
+// ToSchemaOrg converts the address to a schema.org PostalAddress.
+//
+// The premise, street, house number and unit are written to streetAddress, in the order used by the country,
+// the post box number to postOfficeBoxNumber and the country code, or else its name, to addressCountry.
+// A postal code extension is appended to the postal code, eg. 94105-1420.
+// The report lists what schema.org cannot carry, eg. dependent localities, post offices and the attributes of the address.
+func ToSchemaOrg(a *AddressDetails) (*SchemaOrgAddress, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	lines, carried := flat.streetLines()
+	s := &SchemaOrgAddress{
+		Context:             SchemaOrgContext,
+		Type:                "PostalAddress",
+		StreetAddress:       strings.Join(lines, ", "),
+		PostOfficeBoxNumber: flat[ComponentPostBox],
+		AddressLocality:     flat[ComponentLocality],
+		AddressRegion:       flat[ComponentAdminArea],
+		PostalCode:          joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		AddressCountry:      flat[ComponentCountryCode],
+	}
+	carried = append(carried, ComponentPostBox, ComponentLocality, ComponentAdminArea,
+		ComponentPostalCode, ComponentPostalCodeExtension, ComponentCountryCode)
+	if s.AddressCountry == "" {
+		s.AddressCountry = flat[ComponentCountryName]
+		carried = append(carried, ComponentCountryName)
+	}
+	return s, f.dropped(carried...)
+}
+
+var (
+	countryCodeRE = regexp.MustCompile(`^[A-Za-z]{2,3}$`)
+	zipPlus4RE    = regexp.MustCompile(`^(\d{5})-(\d{4})$`)
+)
+
+// FromSchemaOrg converts a schema.org PostalAddress to an address.
+//
+// streetAddress is split on commas and new lines, and parsed on a best-effort basis:
+// a line such as Apt 4 becomes a sub-premise, the line holding the house number the thoroughfare
+// and the premise, and the other lines the name of the premise. A US ZIP+4 code is split into the
+// postal code and its extension.
+//
+// Every property of a PostalAddress has a place in the address, so the report is always empty;
+// it is returned like those of the other conversions.
+func FromSchemaOrg(s *SchemaOrgAddress) (*AddressDetails, Report) {
+	if s == nil {
+		return nil, Report{}
+	}
+	country := strings.TrimSpace(s.AddressCountry)
+	lines := strings.FieldsFunc(s.StreetAddress, func(r rune) bool { return r == ',' || r == '\n' })
+	flat := parseStreetLines(lines, country)
+	if countryCodeRE.MatchString(country) {
+		country = strings.ToUpper(country)
+		flat[ComponentCountryCode] = country
+	} else {
+		flat[ComponentCountryName] = country
+	}
+	flat[ComponentLocality] = strings.TrimSpace(s.AddressLocality)
+	flat[ComponentAdminArea] = strings.TrimSpace(s.AddressRegion)
+	flat[ComponentPostBox] = strings.TrimSpace(s.PostOfficeBoxNumber)
+	flat[ComponentPostalCode] = strings.TrimSpace(s.PostalCode)
+	if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && country == "US" {
+		flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+	}
+	return flat.build(), Report{}
+}
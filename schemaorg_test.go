@@ -0,0 +1,43 @@
+package xal
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSchemaOrgRoundTrip(t *testing.T) {
+	tests := []string{
+		`{"@context":"https://schema.org","@type":"PostalAddress","streetAddress":"1 Market St, Apt 4","addressLocality":"San Francisco","addressRegion":"CA","postalCode":"94105-1420","addressCountry":"US"}`,
+		`{"@context":"https://schema.org","@type":"PostalAddress","streetAddress":"Karl Johans gate 22","addressLocality":"Oslo","postalCode":"0159","addressCountry":"NO"}`,
+		`{"@context":"https://schema.org","@type":"PostalAddress","postOfficeBoxNumber":"1234","addressLocality":"Oslo","addressCountry":"Norway"}`,
+	}
+	for _, doc := range tests {
+		t.Run(doc, func(t *testing.T) {
+			var s SchemaOrgAddress
+			if err := json.Unmarshal([]byte(doc), &s); err != nil {
+				t.Fatal(err)
+			}
+			a, r := FromSchemaOrg(&s)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromSchemaOrg dropped %v", r.Dropped)
+			}
+			got, r := ToSchemaOrg(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToSchemaOrg dropped %v", r.Dropped)
+			}
+			if b, _ := json.Marshal(got); string(b) != doc {
+				t.Errorf("got %s\nwant %s", b, doc)
+			}
+		})
+	}
+}
+
+func TestSchemaOrgCountryObject(t *testing.T) {
+	var s SchemaOrgAddress
+	if err := json.Unmarshal([]byte(`{"@type":"PostalAddress","addressCountry":{"@type":"Country","name":"US"}}`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.AddressCountry != "US" {
+		t.Errorf("addressCountry = %q, want US", s.AddressCountry)
+	}
+}
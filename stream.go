@@ -0,0 +1,296 @@
+package xal
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// XALNamespace is the namespace of the elements of xAL 2.0 XML documents.
+const XALNamespace = "urn:oasis:names:tc:ciq:xsdschema:xAL:2.0"
+
+// ErrInvalidDocument is returned by a Decoder for a document that is not a list of addresses.
+var ErrInvalidDocument = errors.New("xal: invalid XAL document")
+
+// Decoder - Reads the addresses of an XAL document one at a time, so that documents of any size
+// are read in bounded memory: only the address being decoded is held.
+type Decoder struct {
+	json    *json.Decoder
+	xml     *xml.Decoder
+	version string
+	started bool // The start of the document was read
+	inList  bool // The decoder is in the list of addresses
+	object  bool // The JSON document is an XAL object, not a bare list of addresses
+	done    bool
+}
+
+// NewJSONDecoder returns a decoder of the XAL JSON document read from r, eg. {"address_details": [...]},
+// or of a bare JSON array of addresses.
+func NewJSONDecoder(r io.Reader) *Decoder {
+	return &Decoder{json: json.NewDecoder(r)}
+}
+
+// NewXMLDecoder returns a decoder of the xAL XML document read from r, eg. <xAL><AddressDetails>...</xAL>,
+// or of a document made of a single AddressDetails element, enforcing DefaultXMLLimits.
+func NewXMLDecoder(r io.Reader) *Decoder {
+	return NewXMLDecoderLimits(r, DefaultXMLLimits)
+}
+
+// NewXMLDecoderLimits returns a decoder of the xAL XML document read from r, enforcing the limits,
+// see NewXMLDecoder and XMLLimits.NewDecoder. In an xAL document, which can hold any number of addresses,
+// MaxElements limits the elements of each address and MaxDocumentElements those of the document.
+// Exceeding a limit is an *XMLLimitError, after which the iteration stops.
+func NewXMLDecoderLimits(r io.Reader, limits XMLLimits) *Decoder {
+	return &Decoder{xml: xml.NewTokenDecoder(newXMLLimiter(r, limits, true))}
+}
+
+// Addresses returns an iterator over the addresses of the document, in document order.
+//
+// The iteration stops after the first error, yielded with a nil address. Breaking out of a loop
+// over the iterator leaves the decoder at the next address, where a new iteration resumes.
+func (d *Decoder) Addresses() iter.Seq2[*AddressDetails, error] {
+	return func(yield func(*AddressDetails, error) bool) {
+		for {
+			a, err := d.next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				d.done = true
+				yield(nil, err)
+				return
+			}
+			if !yield(a, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Version returns the AttrVersion of the document, once read: from the start of an XML document,
+// and from a JSON document once the attr_version member is reached.
+func (d *Decoder) Version() string {
+	return d.version
+}
+
+// next returns the next address, or io.EOF at the end of the document.
+func (d *Decoder) next() (*AddressDetails, error) {
+	if d.done {
+		return nil, io.EOF
+	}
+	if d.json != nil {
+		return d.nextJSON()
+	}
+	return d.nextXML()
+}
+
+func (d *Decoder) nextJSON() (*AddressDetails, error) {
+	dec := d.json
+	if !d.started {
+		d.started = true
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch tok {
+		case json.Delim('{'):
+			d.object = true
+		case json.Delim('['):
+			d.inList = true
+		default:
+			return nil, fmt.Errorf("%w: the document is not an object or an array", ErrInvalidDocument)
+		}
+	}
+	for {
+		if d.inList {
+			for dec.More() {
+				var a *AddressDetails
+				if err := dec.Decode(&a); err != nil {
+					return nil, err
+				}
+				if a != nil {
+					return a, nil
+				}
+			}
+			if _, err := dec.Token(); err != nil { // ]
+				return nil, err
+			}
+			d.inList = false
+			if !d.object {
+				d.done = true
+				return nil, io.EOF
+			}
+		}
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if tok == json.Delim('}') {
+			d.done = true
+			return nil, io.EOF
+		}
+		switch tok {
+		case "address_details":
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			if tok == nil {
+				continue
+			}
+			if tok != json.Delim('[') {
+				return nil, fmt.Errorf("%w: address_details is not an array", ErrInvalidDocument)
+			}
+			d.inList = true
+		case "attr_version":
+			if err := dec.Decode(&d.version); err != nil {
+				return nil, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (d *Decoder) nextXML() (*AddressDetails, error) {
+	dec := d.xml
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF && d.started {
+			return nil, fmt.Errorf("%w: unexpected end of document", ErrInvalidDocument)
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "AddressDetails" {
+				var a AddressDetails
+				if err := dec.DecodeElement(&a, &t); err != nil {
+					return nil, err
+				}
+				if !d.started {
+					d.done = true
+				}
+				return &a, nil
+			}
+			if d.started {
+				if err := dec.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			d.started = true
+			for _, attr := range t.Attr {
+				if attr.Name.Local == "Version" {
+					d.version = attr.Value
+				}
+			}
+		case xml.EndElement:
+			d.done = true
+			return nil, io.EOF
+		}
+	}
+}
+
+// Encoder - Writes an XAL document one address at a time, in bounded memory.
+// Each address is written out once encoded, and Close ends the document.
+type Encoder struct {
+	w       *bufio.Writer
+	xml     *xml.Encoder
+	version string
+	started bool
+	count   int
+}
+
+// NewJSONEncoder returns an encoder of an XAL JSON document, eg. {"address_details": [...]}, to w.
+func NewJSONEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// NewXMLEncoder returns an encoder of an xAL XML document, eg. <xAL><AddressDetails>...</xAL>, to w.
+func NewXMLEncoder(w io.Writer) *Encoder {
+	return &Encoder{xml: xml.NewEncoder(w)}
+}
+
+// SetVersion sets the AttrVersion of the document. It has no effect once an address is encoded.
+func (e *Encoder) SetVersion(v string) {
+	e.version = v
+}
+
+// Encode writes the address to the document. Nil addresses are skipped.
+func (e *Encoder) Encode(a *AddressDetails) error {
+	if a == nil {
+		return nil
+	}
+	if err := e.start(); err != nil {
+		return err
+	}
+	e.count++
+	if e.xml != nil {
+		return e.xml.EncodeElement(a, xml.StartElement{Name: xml.Name{Local: "AddressDetails"}})
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	if e.count > 1 {
+		e.w.WriteString(",")
+	}
+	e.w.WriteString("\n")
+	e.w.Write(b)
+	return e.w.Flush()
+}
+
+// Close ends the document, which holds no address if none was encoded. It does not close the underlying writer.
+func (e *Encoder) Close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if e.xml != nil {
+		if err := e.xml.EncodeToken(xml.EndElement{Name: xml.Name{Local: "xAL"}}); err != nil {
+			return err
+		}
+		return e.xml.Close()
+	}
+	if e.count > 0 {
+		e.w.WriteString("\n")
+	}
+	e.w.WriteString("]}\n")
+	return e.w.Flush()
+}
+
+// start writes the start of the document, once.
+func (e *Encoder) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+	if e.xml != nil {
+		root := xml.StartElement{
+			Name: xml.Name{Local: "xAL"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: XALNamespace}},
+		}
+		if e.version != "" {
+			root.Attr = append(root.Attr, xml.Attr{Name: xml.Name{Local: "Version"}, Value: e.version})
+		}
+		if err := e.xml.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="UTF-8"`)}); err != nil {
+			return err
+		}
+		return e.xml.EncodeToken(root)
+	}
+	e.w.WriteString("{")
+	if e.version != "" {
+		v, _ := json.Marshal(e.version)
+		fmt.Fprintf(e.w, `"attr_version":%s,`, v)
+	}
+	e.w.WriteString(`"address_details":[`)
+	return nil
+}
@@ -0,0 +1,144 @@
+package xal
+
+import (
+	"regexp"
+	"strings"
+)
+
+// numberAfterStreet lists the countries writing the house number after the street name, eg. Hauptstraße 5.
+var numberAfterStreet = map[string]bool{
+	"AR": true, "AT": true, "BE": true, "BR": true, "CH": true, "CL": true, "CZ": true, "DE": true,
+	"DK": true, "ES": true, "FI": true, "HR": true, "IS": true, "IT": true, "MX": true, "NL": true,
+	"NO": true, "PL": true, "PT": true, "SE": true, "SI": true, "SK": true,
+}
+
+// unitBeforeStreet lists the countries writing the unit before the street, eg. Flat 4, 10 Downing Street.
+var unitBeforeStreet = map[string]bool{"AU": true, "GB": true, "IE": true, "NZ": true}
+
+// streetComponents are the components carried by the lines returned by streetLines.
+var streetComponents = []Component{
+	ComponentPremiseName, ComponentBuildingName,
+	ComponentStreetName, ComponentStreetLeadingType, ComponentStreetType,
+	ComponentStreetPreDirection, ComponentStreetPostDirection, ComponentDependentStreetName,
+	ComponentHouseNumber, ComponentHouseNumberSuffix,
+	ComponentUnitType, ComponentUnit, ComponentUnitName,
+}
+
+// streetLines formats the delivery point of the address as it is written on an envelope, eg.
+// "1 Market St" and "Apt 4": the premise and building names, the street with its house number and the unit,
+// in the order used by the country. Addresses made of address lines only return their lines.
+//
+// It also returns the components the lines carry.
+func (f Flat) streetLines() (lines []string, carried []Component) {
+	country := strings.ToUpper(f[ComponentCountryCode])
+	number := f[ComponentHouseNumber] + f[ComponentHouseNumberSuffix]
+	street := joinNonEmpty([]string{
+		f[ComponentStreetPreDirection], f[ComponentStreetLeadingType], f[ComponentStreetName],
+		f[ComponentStreetType], f[ComponentStreetPostDirection],
+	}, " ")
+	withNumber := func(street string) string {
+		if numberAfterStreet[country] {
+			return joinNonEmpty([]string{street, number}, " ")
+		}
+		return joinNonEmpty([]string{number, street}, " ")
+	}
+	var streets []string
+	if dep := f[ComponentDependentStreetName]; dep != "" {
+		// The number belongs to the dependent thoroughfare, eg. 10 Back Lane, High Street.
+		streets = append(streets, withNumber(dep), street)
+	} else {
+		streets = append(streets, withNumber(street))
+	}
+	unit := joinNonEmpty([]string{f[ComponentUnitType], f[ComponentUnit], f[ComponentUnitName]}, " ")
+
+	lines = append(lines, f[ComponentPremiseName], f[ComponentBuildingName])
+	if unitBeforeStreet[country] {
+		lines = append(lines, unit)
+		lines = append(lines, streets...)
+	} else {
+		lines = append(lines, streets...)
+		lines = append(lines, unit)
+	}
+	lines = nonEmpty(lines)
+	if len(lines) > 0 {
+		return lines, streetComponents
+	}
+	for n := 1; f[AddressLineComponent(n)] != ""; n++ {
+		lines = append(lines, f[AddressLineComponent(n)])
+		carried = append(carried, AddressLineComponent(n))
+	}
+	return lines, carried
+}
+
+var (
+	unitRE        = regexp.MustCompile(`(?i)^(apartment|apt|flat|floor|fl|room|rm|suite|ste|unit|#)\.?\s*([\pL\pN][\pL\pN/-]*)$`)
+	numberFirstRE = regexp.MustCompile(`^(\pN+[\pL\pN/-]*)\s+(.+)$`)
+	numberLastRE  = regexp.MustCompile(`^(.+?)\s+(\pN+[\pL\pN/-]*)$`)
+)
+
+// parseStreetLines is the best-effort inverse of streetLines: it recognises the unit, eg. Apt 4,
+// and the street line starting or ending with a house number, depending on the country.
+// The street keeps its type, eg. Market St. Other lines become the premise name.
+func parseStreetLines(lines []string, country string) Flat {
+	f := Flat{}
+	lines = nonEmpty(lines)
+	street := -1
+	for i, l := range lines {
+		if !unitRE.MatchString(l) && (numberFirstRE.MatchString(l) || numberLastRE.MatchString(l)) {
+			street = i
+			break
+		}
+	}
+	if street < 0 {
+		for i, l := range lines {
+			if !unitRE.MatchString(l) {
+				street = i
+				break
+			}
+		}
+	}
+	var premise []string
+	for i, l := range lines {
+		if i == street {
+			f[ComponentStreetName] = l
+			first, last := numberFirstRE, numberLastRE
+			if numberAfterStreet[strings.ToUpper(country)] {
+				first, last = last, first
+			}
+			if m := first.FindStringSubmatch(l); m != nil {
+				f[ComponentStreetName], f[ComponentHouseNumber] = streetAndNumber(first, m)
+			} else if m := last.FindStringSubmatch(l); m != nil {
+				f[ComponentStreetName], f[ComponentHouseNumber] = streetAndNumber(last, m)
+			}
+			continue
+		}
+		if m := unitRE.FindStringSubmatch(l); m != nil && f[ComponentUnit] == "" {
+			f[ComponentUnitType], f[ComponentUnit] = m[1], m[2]
+			continue
+		}
+		premise = append(premise, l)
+	}
+	if len(premise) > 0 {
+		f[ComponentPremiseName] = strings.Join(premise, ", ")
+	}
+	return f
+}
+
+// streetAndNumber returns the street and the house number matched by re, one of numberFirstRE and numberLastRE.
+func streetAndNumber(re *regexp.Regexp, m []string) (street, number string) {
+	if re == numberFirstRE {
+		return m[2], m[1]
+	}
+	return m[1], m[2]
+}
+
+// nonEmpty returns the trimmed, non-empty strings of ss.
+func nonEmpty(ss []string) []string {
+	var out []string
+	for _, s := range ss {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,250 @@
+package xal
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Namespaces of the UBL 2.x common components.
+const (
+	UBLAggregateNamespace = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	UBLBasicNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+)
+
+// UBLAddress - A UBL 2.x address, such as the cac:Address of a party or its cac:PostalAddress, eg.
+//
+//	<cac:PostalAddress>
+//	  <cbc:StreetName>Main Street</cbc:StreetName>
+//	  <cbc:BuildingNumber>1</cbc:BuildingNumber>
+//	  <cbc:CityName>Oslo</cbc:CityName>
+//	  <cbc:PostalZone>0150</cbc:PostalZone>
+//	  <cac:Country><cbc:IdentificationCode>NO</cbc:IdentificationCode></cac:Country>
+//	</cac:PostalAddress>
+//
+// Elements are decoded whatever their prefix, and encoded with the cac and cbc prefixes,
+// which are declared on the address. Other elements of the UBL AddressType are ignored.
+type UBLAddress struct {
+	XMLName              xml.Name         // cac:Address or cac:PostalAddress, see MarshalXML
+	Postbox              string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 Postbox,omitempty"`
+	StreetName           string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 StreetName,omitempty"`
+	AdditionalStreetName string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 AdditionalStreetName,omitempty"`
+	BuildingName         string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 BuildingName,omitempty"`
+	BuildingNumber       string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 BuildingNumber,omitempty"`
+	Department           string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 Department,omitempty"`
+	CitySubdivisionName  string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 CitySubdivisionName,omitempty"`
+	CityName             string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 CityName,omitempty"`
+	PostalZone           string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 PostalZone,omitempty"`
+	CountrySubentity     string           `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 CountrySubentity,omitempty"`
+	AddressLines         []UBLAddressLine `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2 AddressLine,omitempty"`
+	Country              *UBLCountry      `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2 Country,omitempty"`
+}
+
+// UBLAddressLine - A cac:AddressLine, a free format line of an address.
+type UBLAddressLine struct {
+	Line string `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 Line"`
+}
+
+// UBLCountry - A cac:Country.
+type UBLCountry struct {
+	IdentificationCode string `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 IdentificationCode,omitempty"` // ISO 3166-1 alpha-2 code
+	Name               string `xml:"urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2 Name,omitempty"`
+}
+
+// MarshalXML encodes the address with the cac and cbc prefixes, in the order of the UBL schema.
+// The element is named after XMLName, or else after start, eg. Address for a field tagged xml:"Address",
+// and is a cac:PostalAddress if neither names it: encoding/xml names start after the type by default.
+func (u UBLAddress) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	name := u.XMLName.Local
+	if name == "" {
+		name = start.Name.Local
+	}
+	if name == "" || name == "UBLAddress" {
+		name = "PostalAddress"
+	}
+	start = xml.StartElement{
+		Name: xml.Name{Local: "cac:" + name},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:cac"}, Value: UBLAggregateNamespace},
+			{Name: xml.Name{Local: "xmlns:cbc"}, Value: UBLBasicNamespace},
+		},
+	}
+	var tokens []xml.Token
+	basic := func(name, value string) {
+		if value != "" {
+			el := xml.StartElement{Name: xml.Name{Local: "cbc:" + name}}
+			tokens = append(tokens, el, xml.CharData(value), el.End())
+		}
+	}
+	aggregate := func(name string, children func()) {
+		el := xml.StartElement{Name: xml.Name{Local: "cac:" + name}}
+		tokens = append(tokens, el)
+		children()
+		tokens = append(tokens, el.End())
+	}
+	tokens = append(tokens, start)
+	basic("Postbox", u.Postbox)
+	basic("StreetName", u.StreetName)
+	basic("AdditionalStreetName", u.AdditionalStreetName)
+	basic("BuildingName", u.BuildingName)
+	basic("BuildingNumber", u.BuildingNumber)
+	basic("Department", u.Department)
+	basic("CitySubdivisionName", u.CitySubdivisionName)
+	basic("CityName", u.CityName)
+	basic("PostalZone", u.PostalZone)
+	basic("CountrySubentity", u.CountrySubentity)
+	for _, l := range u.AddressLines {
+		aggregate("AddressLine", func() { basic("Line", l.Line) })
+	}
+	if c := u.Country; c != nil {
+		aggregate("Country", func() {
+			basic("IdentificationCode", c.IdentificationCode)
+			basic("Name", c.Name)
+		})
+	}
+	tokens = append(tokens, start.End())
+	for _, t := range tokens {
+		if err := e.EncodeToken(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToUBL converts the address to a UBL cac:PostalAddress.
+//
+// The street goes to StreetName, the dependent thoroughfare to AdditionalStreetName, the premise
+// or building name to BuildingName, the unit to an AddressLine, eg. Apt 4, and the dependent locality
+// to CitySubdivisionName. Addresses made of address lines keep their lines, but for the lines
+// of type Country which become the country.
+// The report lists what UBL cannot carry, eg. post offices and the attributes of the address.
+func ToUBL(a *AddressDetails) (*UBLAddress, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	u := &UBLAddress{
+		XMLName: xml.Name{Space: UBLAggregateNamespace, Local: "PostalAddress"},
+		Postbox: flat[ComponentPostBox],
+		StreetName: joinNonEmpty([]string{
+			flat[ComponentStreetPreDirection], flat[ComponentStreetLeadingType], flat[ComponentStreetName],
+			flat[ComponentStreetType], flat[ComponentStreetPostDirection],
+		}, " "),
+		AdditionalStreetName: flat[ComponentDependentStreetName],
+		BuildingName:         flat[ComponentPremiseName],
+		BuildingNumber:       flat[ComponentHouseNumber] + flat[ComponentHouseNumberSuffix],
+		CitySubdivisionName:  flat[ComponentDependentLocality],
+		CityName:             flat[ComponentLocality],
+		PostalZone:           joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		CountrySubentity:     flat[ComponentAdminArea],
+	}
+	carried := []Component{
+		ComponentPostBox, ComponentStreetPreDirection, ComponentStreetLeadingType, ComponentStreetName,
+		ComponentStreetType, ComponentStreetPostDirection, ComponentDependentStreetName, ComponentPremiseName,
+		ComponentHouseNumber, ComponentHouseNumberSuffix, ComponentDependentLocality, ComponentLocality,
+		ComponentPostalCode, ComponentPostalCodeExtension, ComponentAdminArea,
+	}
+	if u.BuildingName == "" {
+		u.BuildingName = flat[ComponentBuildingName]
+		carried = append(carried, ComponentBuildingName)
+	}
+	if unit := joinNonEmpty([]string{flat[ComponentUnitType], flat[ComponentUnit], flat[ComponentUnitName]}, " "); unit != "" {
+		u.AddressLines = append(u.AddressLines, UBLAddressLine{Line: unit})
+		carried = append(carried, unitComponents...)
+	}
+	country := UBLCountry{IdentificationCode: flat[ComponentCountryCode], Name: flat[ComponentCountryName]}
+	carried = append(carried, ComponentCountryCode, ComponentCountryName)
+	if a.AddressLines != nil {
+		for i, l := range *a.AddressLines {
+			carried = append(carried, AddressLineComponent(i+1))
+			switch {
+			case l == nil || l.Text == "":
+			case strings.EqualFold(l.AttrType, "Country") && countryCodeRE.MatchString(l.Text) && country.IdentificationCode == "":
+				country.IdentificationCode = l.Text
+				f.use(l, "AttrType")
+			case strings.EqualFold(l.AttrType, "Country") && country.Name == "":
+				country.Name = l.Text
+				f.use(l, "AttrType")
+			default:
+				u.AddressLines = append(u.AddressLines, UBLAddressLine{Line: l.Text})
+			}
+		}
+	}
+	if country != (UBLCountry{}) {
+		u.Country = &country
+	}
+	return u, f.dropped(carried...)
+}
+
+// FromUBL converts a UBL address to an address.
+//
+// Address lines such as Apt 4 become the unit, and the other lines the premise name.
+// Addresses with address lines only, but maybe a country, are converted to address lines,
+// the code and the name of the country being kept in last lines of type Country.
+//
+// The report lists the elements that were not converted, as JSON Pointers named after the elements,
+// eg. /Department.
+func FromUBL(u *UBLAddress) (*AddressDetails, Report) {
+	if u == nil {
+		return nil, Report{}
+	}
+	var r Report
+	if strings.TrimSpace(u.Department) != "" {
+		r.Dropped = append(r.Dropped, "/Department")
+	}
+	var lines []string
+	for _, l := range u.AddressLines {
+		lines = append(lines, l.Line)
+	}
+	lines = nonEmpty(lines)
+	var country UBLCountry
+	if u.Country != nil {
+		country = *u.Country
+	}
+	flat := Flat{
+		ComponentPostBox:             strings.TrimSpace(u.Postbox),
+		ComponentStreetName:          strings.TrimSpace(u.StreetName),
+		ComponentDependentStreetName: strings.TrimSpace(u.AdditionalStreetName),
+		ComponentBuildingName:        strings.TrimSpace(u.BuildingName),
+		ComponentHouseNumber:         strings.TrimSpace(u.BuildingNumber),
+		ComponentDependentLocality:   strings.TrimSpace(u.CitySubdivisionName),
+		ComponentLocality:            strings.TrimSpace(u.CityName),
+		ComponentPostalCode:          strings.TrimSpace(u.PostalZone),
+		ComponentAdminArea:           strings.TrimSpace(u.CountrySubentity),
+	}
+	structured := false
+	for _, v := range flat {
+		structured = structured || v != ""
+	}
+	code := strings.ToUpper(strings.TrimSpace(country.IdentificationCode))
+	if structured {
+		flat[ComponentCountryCode] = code
+		flat[ComponentCountryName] = strings.TrimSpace(country.Name)
+		var premise []string
+		for _, l := range lines {
+			if m := unitRE.FindStringSubmatch(l); m != nil && flat[ComponentUnit] == "" {
+				flat[ComponentUnitType], flat[ComponentUnit] = m[1], m[2]
+			} else {
+				premise = append(premise, l)
+			}
+		}
+		flat[ComponentPremiseName] = strings.Join(premise, ", ")
+		if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && code == "US" {
+			flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+		}
+	} else {
+		for i, l := range lines {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	}
+	a := flat.build()
+	if !structured {
+		for _, c := range nonEmpty([]string{code, country.Name}) {
+			if a.AddressLines == nil {
+				a.AddressLines = &AddressLines{}
+			}
+			*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: c})
+		}
+	}
+	return a, r
+}
@@ -0,0 +1,91 @@
+package xal
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestUBLAddressMarshalXMLName(t *testing.T) {
+	type party struct {
+		XMLName xml.Name   `xml:"Party"`
+		Address UBLAddress `xml:"Address"`
+	}
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{"unnamed", UBLAddress{CityName: "Oslo"}, "<cac:PostalAddress "},
+		{"XMLName", UBLAddress{XMLName: xml.Name{Local: "Address"}, CityName: "Oslo"}, "<cac:Address "},
+		{"field", party{Address: UBLAddress{CityName: "Oslo"}}, "<Party><cac:Address "},
+		{"XMLName over field", party{Address: UBLAddress{XMLName: xml.Name{Local: "PostalAddress"}}}, "<Party><cac:PostalAddress "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := xml.Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(string(b), tt.want) {
+				t.Errorf("got %s, want a prefix %q", b, tt.want)
+			}
+		})
+	}
+}
+
+func TestUBLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		u    *UBLAddress
+	}{
+		{"structured", &UBLAddress{
+			StreetName: "Main Street", BuildingNumber: "1", CityName: "Oslo", PostalZone: "0150",
+			CountrySubentity: "Oslo", Country: &UBLCountry{IdentificationCode: "NO"},
+		}},
+		{"unit", &UBLAddress{
+			StreetName: "Main Street", BuildingNumber: "1", CityName: "Springfield", PostalZone: "12345",
+			AddressLines: []UBLAddressLine{{Line: "Apt 4"}}, Country: &UBLCountry{IdentificationCode: "US"},
+		}},
+		{"address lines", &UBLAddress{
+			AddressLines: []UBLAddressLine{{Line: "1 Main Street"}, {Line: "Oslo"}},
+			Country:      &UBLCountry{IdentificationCode: "NO", Name: "Norway"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r := FromUBL(tt.u)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromUBL dropped %v", r.Dropped)
+			}
+			got, r := ToUBL(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToUBL dropped %v", r.Dropped)
+			}
+			got.XMLName = tt.u.XMLName
+			want, _ := xml.Marshal(tt.u)
+			if b, _ := xml.Marshal(got); string(b) != string(want) {
+				t.Errorf("got %s\nwant %s", b, want)
+			}
+		})
+	}
+}
+
+func TestUBLReport(t *testing.T) {
+	u := &UBLAddress{Department: "Accounts", StreetName: "Main Street", CityName: "Oslo", Country: &UBLCountry{IdentificationCode: "NO"}}
+	a, r := FromUBL(u)
+	if want := []string{"/Department"}; strings.Join(r.Dropped, " ") != strings.Join(want, " ") {
+		t.Errorf("FromUBL dropped %v, want %v", r.Dropped, want)
+	}
+	if a.PostalServiceElements != nil {
+		t.Errorf("FromUBL kept the department in %+v", a.PostalServiceElements)
+	}
+
+	a = &AddressDetails{
+		AttrUsage: "Business",
+		Country:   &Country{CountryNameCode: []*CountryNameCode{{Text: "NO"}}, Locality: &Locality{LocalityName: []*LocalityName{{Text: "Oslo"}}}},
+	}
+	if _, r = ToUBL(a); strings.Join(r.Dropped, " ") != "/attr_usage" {
+		t.Errorf("ToUBL dropped %v, want [/attr_usage]", r.Dropped)
+	}
+}
@@ -0,0 +1,114 @@
+package xal
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSONSchema is the JSON Schema (draft 2020-12) of the JSON encoding of an XAL, generated from the model
+// with the constraints that Validate checks. #/$defs/AddressDetails is the schema of a single address.
+//
+//go:embed xal.schema.json
+var JSONSchema []byte
+
+// ValidationError - A constraint that an address does not satisfy.
+type ValidationError struct {
+	Path   Path   // Location of the offending node
+	Field  string // json name of the offending field, if the error is about a single field
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	loc := e.Path.String()
+	if e.Field != "" {
+		loc += "/" + escapePointerToken(e.Field)
+	}
+	if loc == "" {
+		loc = "/"
+	}
+	return fmt.Sprintf("xal: %s: %s", loc, e.Reason)
+}
+
+// ValidationErrors - All the constraints that an address does not satisfy.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validate checks the address against the constraints of the spec annotated in the model,
+// the maxLength of values, the values allowed by an enum and the fields of which at most one can be set.
+//
+// It returns nil or a ValidationErrors listing every violation.
+func (a *AddressDetails) Validate() error {
+	return validate(a)
+}
+
+// Validate checks every address of the list, see AddressDetails.Validate.
+func (x *XAL) Validate() error {
+	return validate(x)
+}
+
+// Validate checks the name against the constraints of the xNL spec annotated in the model, see AddressDetails.Validate.
+func (n *NameDetails) Validate() error {
+	return validate(n)
+}
+
+func validate(node any) error {
+	var errs ValidationErrors
+	Walk(node, func(path Path, node any) error {
+		errs = append(errs, validateNode(path, node)...)
+		return nil
+	})
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (errs ValidationErrors) checkLength(path Path, field, value string, max int) ValidationErrors {
+	if n := utf8.RuneCountInString(value); n > max {
+		return append(errs, &ValidationError{
+			Path:   path,
+			Field:  field,
+			Reason: fmt.Sprintf("%q is %d characters long, the maximum is %d", value, n, max),
+		})
+	}
+	return errs
+}
+
+// checkEnum reports an error when a value is set and is not one of the allowed values.
+func (errs ValidationErrors) checkEnum(path Path, field, value string, allowed ...string) ValidationErrors {
+	if value != "" && !slices.Contains(allowed, value) {
+		return append(errs, &ValidationError{
+			Path:   path,
+			Field:  field,
+			Reason: fmt.Sprintf("%q is not one of %s", value, strings.Join(allowed, ", ")),
+		})
+	}
+	return errs
+}
+
+// checkChoice reports an error when more than one of the fields of a choice is set.
+func (errs ValidationErrors) checkChoice(path Path, fields []string, set ...bool) ValidationErrors {
+	var found []string
+	for i, ok := range set {
+		if ok {
+			found = append(found, fields[i])
+		}
+	}
+	if len(found) > 1 {
+		return append(errs, &ValidationError{
+			Path:   path,
+			Reason: fmt.Sprintf("only one of %s can be set, found %s", strings.Join(fields, ", "), strings.Join(found, ", ")),
+		})
+	}
+	return errs
+}
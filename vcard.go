@@ -0,0 +1,317 @@
+package xal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidVCard is returned when a vCard ADR property cannot be parsed.
+var ErrInvalidVCard = errors.New("xal: invalid vCard ADR property")
+
+// VCardAddress - A vCard ADR property, as defined by RFC 2426 (vCard 3.0) and RFC 6350 (vCard 4.0), eg.
+//
+//	ADR;TYPE=work;LABEL="1 Market St\nApt 4\nSan Francisco CA 94105\nUSA":;Apt 4;1 Market St;San Francisco;CA;94105;USA
+//
+// Components holding several values, separated by commas in the property, are joined with ", ",
+// except for the street whose values are lines joined with "\n".
+type VCardAddress struct {
+	Types         []string // TYPE parameter, lower-cased, eg. home or work
+	Label         string   // LABEL parameter, the formatted address with its lines joined with "\n"
+	PostOfficeBox string
+	Extended      string // Apartment or suite, eg. Apt 4
+	Street        string
+	Locality      string
+	Region        string
+	PostalCode    string
+	Country       string // Name of the country
+}
+
+// ParseVCardADR parses an ADR content line, eg. ADR;TYPE=home:;;1 Market St;San Francisco;CA;94105;USA.
+//
+// Folded lines are unfolded, a group prefix such as item1. is ignored, and vCard 2.1 style
+// types given as bare parameters, eg. ADR;HOME;POSTAL:, are added to the types.
+func ParseVCardADR(line string) (*VCardAddress, error) {
+	for _, fold := range []string{"\r\n ", "\r\n\t", "\n ", "\n\t"} {
+		line = strings.ReplaceAll(line, fold, "")
+	}
+	line = strings.TrimRight(line, "\r\n")
+	head, value, ok := cutUnquoted(line, ':')
+	if !ok {
+		return nil, fmt.Errorf("%w: missing ':' in %q", ErrInvalidVCard, line)
+	}
+	params := splitUnquoted(head, ';')
+	name := params[0]
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	if !strings.EqualFold(name, "ADR") {
+		return nil, fmt.Errorf("%w: property is %s", ErrInvalidVCard, name)
+	}
+	v := &VCardAddress{}
+	for _, p := range params[1:] {
+		key, val, ok := strings.Cut(p, "=")
+		switch {
+		case !ok:
+			v.Types = append(v.Types, strings.ToLower(p))
+		case strings.EqualFold(key, "TYPE"):
+			for _, t := range splitUnquoted(val, ',') {
+				v.Types = append(v.Types, strings.ToLower(unquoteParam(t)))
+			}
+		case strings.EqualFold(key, "LABEL"):
+			v.Label = unquoteParam(val)
+		}
+	}
+	comps := splitEscaped(value, ';')
+	if len(comps) > 7 {
+		return nil, fmt.Errorf("%w: %d components, the maximum is 7", ErrInvalidVCard, len(comps))
+	}
+	comps = append(comps, make([]string, 7-len(comps))...)
+	list := func(s, sep string) string {
+		values := splitEscaped(s, ',')
+		for i, v := range values {
+			values[i] = unescapeVCard(v)
+		}
+		return strings.Join(nonEmpty(values), sep)
+	}
+	v.PostOfficeBox = list(comps[0], ", ")
+	v.Extended = list(comps[1], ", ")
+	v.Street = list(comps[2], "\n")
+	v.Locality = list(comps[3], ", ")
+	v.Region = list(comps[4], ", ")
+	v.PostalCode = list(comps[5], ", ")
+	v.Country = list(comps[6], ", ")
+	return v, nil
+}
+
+// String encodes the property as a vCard 4.0 ADR content line, without folding.
+func (v *VCardAddress) String() string {
+	var b strings.Builder
+	b.WriteString("ADR")
+	if len(v.Types) > 0 {
+		b.WriteString(";TYPE=" + strings.Join(v.Types, ","))
+	}
+	if v.Label != "" {
+		b.WriteString(";LABEL=" + quoteParam(v.Label))
+	}
+	b.WriteByte(':')
+	streets := strings.Split(v.Street, "\n")
+	for i, s := range streets {
+		streets[i] = escapeVCard(s)
+	}
+	comps := []string{
+		escapeVCard(v.PostOfficeBox), escapeVCard(v.Extended), strings.Join(streets, ","),
+		escapeVCard(v.Locality), escapeVCard(v.Region), escapeVCard(v.PostalCode), escapeVCard(v.Country),
+	}
+	b.WriteString(strings.Join(comps, ";"))
+	return b.String()
+}
+
+// unitComponents are the components written to the extended address of a vCard.
+var unitComponents = []Component{ComponentUnitType, ComponentUnit, ComponentUnitName}
+
+// ToVCard converts the address to a vCard ADR property.
+//
+// The unit goes to the extended address, the premise, street and house number to the street,
+// the name of the country, or else its code, to the country, and a postal code extension is
+// appended to the postal code, eg. 94105-1420. AttrUsage and AttrAddressType are mapped to types,
+// eg. Home or Residential to home and Business to work, and the label is the address formatted in lines.
+// The report lists what vCard cannot carry, eg. dependent localities and post offices.
+func ToVCard(a *AddressDetails) (*VCardAddress, Report) {
+	if a == nil {
+		return nil, Report{}
+	}
+	f := newFlattener(a)
+	flat := f.flat
+	street := maps.Clone(flat)
+	for _, c := range unitComponents {
+		delete(street, c)
+	}
+	lines, carried := street.streetLines()
+	v := &VCardAddress{
+		PostOfficeBox: flat[ComponentPostBox],
+		Extended:      joinNonEmpty([]string{flat[ComponentUnitType], flat[ComponentUnit], flat[ComponentUnitName]}, " "),
+		Street:        strings.Join(lines, "\n"),
+		Locality:      flat[ComponentLocality],
+		Region:        flat[ComponentAdminArea],
+		PostalCode:    joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, "-"),
+		Country:       flat[ComponentCountryName],
+	}
+	carried = append(carried, unitComponents...)
+	carried = append(carried, ComponentPostBox, ComponentLocality, ComponentAdminArea,
+		ComponentPostalCode, ComponentPostalCodeExtension, ComponentCountryName)
+	if v.Country == "" {
+		v.Country = flat[ComponentCountryCode]
+		carried = append(carried, ComponentCountryCode)
+	}
+	if t := vcardUsageTypes[strings.ToLower(flat[ComponentUsage])]; t != "" {
+		v.Types = append(v.Types, t)
+		carried = append(carried, ComponentUsage)
+	}
+	if t := vcardAddressTypes[strings.ToLower(flat[ComponentAddressType])]; t != "" {
+		if len(v.Types) == 0 || v.Types[0] != t {
+			v.Types = append(v.Types, t)
+		}
+		carried = append(carried, ComponentAddressType)
+	}
+	full, _ := flat.streetLines()
+	if v.PostOfficeBox != "" {
+		full = append(full, "PO Box "+v.PostOfficeBox)
+	}
+	full = append(full, joinNonEmpty([]string{v.Locality, v.Region, v.PostalCode}, " "), v.Country)
+	v.Label = strings.Join(nonEmpty(full), "\n")
+	return v, f.dropped(carried...)
+}
+
+// vcardUsageTypes maps the lower-cased AttrUsage of an address to a vCard type.
+var vcardUsageTypes = map[string]string{
+	"home": "home", "personal": "home", "private": "home",
+	"work": "work", "business": "work", "office": "work",
+}
+
+// vcardAddressTypes maps the lower-cased AttrAddressType of an address to a vCard type.
+// The postal, parcel, dom and intl types only exist in vCard 3.0.
+var vcardAddressTypes = map[string]string{
+	"home": "home", "residential": "home",
+	"work": "work", "business": "work", "commercial": "work",
+	"postal": "postal", "parcel": "parcel", "domestic": "dom", "international": "intl",
+}
+
+// FromVCard converts a vCard ADR property to an address.
+//
+// The street and extended address are parsed on a best-effort basis, see FromSchemaOrg.
+// The home and work types set AttrUsage to Home and Work, and the vCard 3.0 postal, parcel, dom and intl types
+// set AttrAddressType to Postal, Parcel, Domestic and International. When all the components are empty,
+// the lines of the label become the address lines of the address.
+//
+// The report lists the types that were not converted, as JSON Pointers named after the fields,
+// eg. /Types/1 for a second usage or an unknown type. The label of an address with components is not
+// reported, it is a formatting of the components.
+func FromVCard(v *VCardAddress) (*AddressDetails, Report) {
+	if v == nil {
+		return nil, Report{}
+	}
+	var r Report
+	country := strings.TrimSpace(v.Country)
+	lines := append(strings.Split(v.Street, "\n"), v.Extended)
+	flat := parseStreetLines(lines, country)
+	if countryCodeRE.MatchString(country) {
+		flat[ComponentCountryCode] = strings.ToUpper(country)
+	} else {
+		flat[ComponentCountryName] = country
+	}
+	flat[ComponentPostBox] = strings.TrimSpace(v.PostOfficeBox)
+	flat[ComponentLocality] = strings.TrimSpace(v.Locality)
+	flat[ComponentAdminArea] = strings.TrimSpace(v.Region)
+	flat[ComponentPostalCode] = strings.TrimSpace(v.PostalCode)
+	if m := zipPlus4RE.FindStringSubmatch(flat[ComponentPostalCode]); m != nil && flat[ComponentCountryCode] == "US" {
+		flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+	}
+	for i, t := range v.Types {
+		switch t = strings.ToLower(t); {
+		case (t == "home" || t == "work") && flat[ComponentUsage] == "":
+			flat[ComponentUsage] = strings.ToUpper(t[:1]) + t[1:]
+		case (t == "postal" || t == "parcel" || t == "dom" || t == "intl") && flat[ComponentAddressType] == "":
+			flat[ComponentAddressType] = map[string]string{
+				"postal": "Postal", "parcel": "Parcel", "dom": "Domestic", "intl": "International",
+			}[t]
+		default:
+			r.Dropped = append(r.Dropped, Path{"Types", strconv.Itoa(i)}.String())
+		}
+	}
+	empty := true
+	for c, val := range flat {
+		if val != "" && c != ComponentUsage && c != ComponentAddressType {
+			empty = false
+		}
+	}
+	if empty {
+		for i, l := range nonEmpty(strings.Split(v.Label, "\n")) {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	}
+	return flat.build(), r
+}
+
+// escapeVCard escapes a value of a structured property.
+func escapeVCard(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// unescapeVCard reverses escapeVCard.
+func unescapeVCard(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// quoteParam quotes a parameter value, escaping new lines as \n and the quotes and carets as in RFC 6868.
+func quoteParam(s string) string {
+	return `"` + strings.NewReplacer("^", "^^", `"`, "^'", "\r\n", `\n`, "\n", `\n`).Replace(s) + `"`
+}
+
+// unquoteParam reverses quoteParam, also accepting the ^n escape of RFC 6868 and unquoted values.
+func unquoteParam(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	return strings.NewReplacer("^^", "^", "^'", `"`, "^n", "\n", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+// splitEscaped splits s on the occurrences of sep that are not escaped by a backslash.
+// The escapes are kept.
+func splitEscaped(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// splitUnquoted splits s on the occurrences of sep that are not inside double quotes.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	for {
+		before, after, ok := cutUnquoted(s, sep)
+		parts = append(parts, before)
+		if !ok {
+			return parts
+		}
+		s = after
+	}
+}
+
+// cutUnquoted cuts s around the first occurrence of sep that is not inside double quotes.
+func cutUnquoted(s string, sep byte) (before, after string, found bool) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == sep && !quoted:
+			return s[:i], s[i+1:], true
+		}
+	}
+	return s, "", false
+}
@@ -0,0 +1,45 @@
+package xal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVCardRoundTrip(t *testing.T) {
+	tests := []string{
+		"ADR;TYPE=work:;;1 Market St;San Francisco;CA;94105;US",
+		"ADR;TYPE=home,postal:;Apt 4;1 Main St;Springfield;IL;12345-6789;US",
+		"ADR:123;;;Oslo;;0150;Norway",
+	}
+	for _, line := range tests {
+		t.Run(line, func(t *testing.T) {
+			v, err := ParseVCardADR(line)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a, r := FromVCard(v)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromVCard dropped %v", r.Dropped)
+			}
+			got, r := ToVCard(a)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToVCard dropped %v", r.Dropped)
+			}
+			got.Label = ""
+			if got.String() != line {
+				t.Errorf("got %s, want %s", got, line)
+			}
+		})
+	}
+}
+
+func TestFromVCardReport(t *testing.T) {
+	v := &VCardAddress{Types: []string{"home", "work", "postal", "intl", "x-cottage"}, Locality: "Oslo"}
+	a, r := FromVCard(v)
+	if got, want := strings.Join(r.Dropped, " "), "/Types/1 /Types/3 /Types/4"; got != want {
+		t.Errorf("FromVCard dropped %q, want %q", got, want)
+	}
+	if a.AttrUsage != "Home" || a.AttrAddressType != "Postal" {
+		t.Errorf("FromVCard usage %q and type %q, want Home and Postal", a.AttrUsage, a.AttrAddressType)
+	}
+}
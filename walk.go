@@ -0,0 +1,81 @@
+package xal
+
+//go:generate go run ./cmd/xalgen -d . -limits schema/limits.txt schema/xAL.xsd schema/xNL.xsd
+//go:generate go run gen_model.go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Sentinel errors returned by a WalkFunc to control the walk.
+var (
+	// SkipSubtree tells Walk not to descend into the children of the current node.
+	SkipSubtree = errors.New("xal: skip subtree")
+	// SkipAll tells Walk to stop walking; Walk then returns nil.
+	SkipAll = errors.New("xal: skip all")
+)
+
+// Path - Location of a node in an xAL tree: the json names and slice indexes leading to it from the root of the walk.
+type Path []string
+
+// String returns the path as a JSON Pointer, eg. /address_details/0/country, which can be used in a Patch.
+func (p Path) String() string {
+	var b strings.Builder
+	for _, elem := range p {
+		b.WriteByte('/')
+		b.WriteString(escapePointerToken(elem))
+	}
+	return b.String()
+}
+
+// child returns a new path extended with elems, which never shares storage with p.
+func (p Path) child(elems ...string) Path {
+	return append(p[:len(p):len(p)], elems...)
+}
+
+// WalkFunc - Called by Walk for every node of the tree, eg. a *Country or a *ThoroughfareName.
+//
+// Returning SkipSubtree skips the children of node, returning SkipAll stops the walk,
+// and any other error stops the walk and is returned by Walk.
+type WalkFunc func(path Path, node any) error
+
+// Walk calls fn for node and each of its descendants, depth first and in field order.
+//
+// node must be a pointer to one of the xAL or xNL types, eg. *XAL, *AddressDetails or *NameDetails; a nil node is not visited.
+// Nil children are not visited, and elements of slices are visited with their index as the last element of the path.
+func Walk(node any, fn WalkFunc) error {
+	if !isNode(node) {
+		return fmt.Errorf("xal: cannot walk %T", node)
+	}
+	if reflect.ValueOf(node).IsNil() {
+		return nil
+	}
+	if err := walk(nil, node, fn); err != SkipAll {
+		return err
+	}
+	return nil
+}
+
+// Visit walks node and, for each node, calls the method of the matching typed visitor interface
+// implemented by v, eg. VisitCountry when v is a CountryVisitor. Nodes whose visitor interface
+// is not implemented by v are walked through silently.
+//
+// Visitor methods control the walk the same way a WalkFunc does.
+func Visit(node any, v any) error {
+	return Walk(node, func(path Path, node any) error {
+		return visit(v, path, node)
+	})
+}
+
+func walk(path Path, node any, fn WalkFunc) error {
+	if err := fn(path, node); err != nil {
+		if err == SkipSubtree {
+			return nil
+		}
+		return err
+	}
+	return walkChildren(path, node, fn)
+}
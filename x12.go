@@ -0,0 +1,363 @@
+package xal
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidX12 is returned when X12 segments cannot be parsed.
+var ErrInvalidX12 = errors.New("xal: invalid X12 segments")
+
+// X12Party - An ANSI ASC X12 party identification loop: an N1 segment followed by its N2, N3 and N4 segments, eg.
+//
+//	N1*ST*Acme Corp*92*1234~N3*1 Market St*Suite 100~N4*San Francisco*CA*941051234*US~
+//
+// Trailing comments give the element and its type and length, eg. AN 1-60 for up to 60 characters.
+type X12Party struct {
+	EntityCode         string   // N101 ID 2-3, mandatory, eg. ST ship to or BT bill to
+	Name               string   // N102 AN 1-60
+	IDQualifier        string   // N103 ID 1-2, eg. 92 assigned by buyer
+	ID                 string   // N104 AN 2-80
+	AdditionalNames    []string // N201 and N202 AN 1-60
+	AddressLines       []string // N301 and N302 AN 1-55, of up to 2 N3 segments
+	City               string   // N401 AN 2-30
+	State              string   // N402 ID 2, eg. CA
+	PostalCode         string   // N403 ID 3-15, eg. 941051234
+	Country            string   // N404 ID 2-3, eg. US
+	LocationQualifier  string   // N405 ID 1-2
+	LocationID         string   // N406 AN 1-30
+	CountrySubdivision string   // N407 ID 1-3, for countries other than US and CA
+}
+
+// x12Delimiters are the delimiters of X12Party.Segments, which values cannot hold as X12 has no release character.
+const x12Delimiters = "*~:"
+
+// zipNineRE matches a US ZIP+4 code written without its hyphen, as in X12, eg. 941051234.
+var zipNineRE = regexp.MustCompile(`^(\d{5})(\d{4})$`)
+
+// Validate checks the loop against the minimum and maximum lengths and the repetitions of its elements,
+// and the delimiters of its values.
+//
+// It returns nil or a ValidationErrors whose paths name the segment and the element, eg. /N1/N102,
+// so that values too long for the segments are reported rather than truncated.
+func (p *X12Party) Validate() error {
+	var errs ValidationErrors
+	if p.EntityCode == "" {
+		errs = append(errs, &ValidationError{Path: Path{"N1"}, Field: "N101", Reason: "entity identifier code is required"})
+	}
+	if len(p.AdditionalNames) > 2 {
+		errs = append(errs, &ValidationError{
+			Path:   Path{"N2"},
+			Reason: fmt.Sprintf("%d additional names, the maximum is 2", len(p.AdditionalNames)),
+		})
+	}
+	if len(p.AddressLines) > 4 {
+		errs = append(errs, &ValidationError{
+			Path:   Path{"N3"},
+			Reason: fmt.Sprintf("%d address lines, the maximum is 4 in 2 N3 segments", len(p.AddressLines)),
+		})
+	}
+	check := func(path Path, element, value string, min, max int) {
+		errs = errs.checkLength(path, element, value, max)
+		if n := utf8.RuneCountInString(value); n > 0 && n < min {
+			errs = append(errs, &ValidationError{
+				Path:   path,
+				Field:  element,
+				Reason: fmt.Sprintf("%q is %d characters long, the minimum is %d", value, n, min),
+			})
+		}
+		if strings.ContainsAny(value, x12Delimiters) {
+			errs = append(errs, &ValidationError{
+				Path:   path,
+				Field:  element,
+				Reason: fmt.Sprintf("%q holds one of the delimiters %s", value, x12Delimiters),
+			})
+		}
+	}
+	check(Path{"N1"}, "N101", p.EntityCode, 2, 3)
+	check(Path{"N1"}, "N102", p.Name, 1, 60)
+	check(Path{"N1"}, "N103", p.IDQualifier, 1, 2)
+	check(Path{"N1"}, "N104", p.ID, 2, 80)
+	for i, n := range p.AdditionalNames {
+		check(Path{"N2"}, fmt.Sprintf("N20%d", i+1), n, 1, 60)
+	}
+	for i, l := range p.AddressLines {
+		check(Path{"N3", strconv.Itoa(i / 2)}, fmt.Sprintf("N30%d", i%2+1), l, 1, 55)
+	}
+	check(Path{"N4"}, "N401", p.City, 2, 30)
+	check(Path{"N4"}, "N402", p.State, 2, 2)
+	check(Path{"N4"}, "N403", p.PostalCode, 3, 15)
+	check(Path{"N4"}, "N404", p.Country, 2, 3)
+	check(Path{"N4"}, "N405", p.LocationQualifier, 1, 2)
+	check(Path{"N4"}, "N406", p.LocationID, 1, 30)
+	check(Path{"N4"}, "N407", p.CountrySubdivision, 1, 3)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Segments validates the loop and encodes its segments with the element separator * and the segment terminator ~,
+// leaving out the empty segments and the trailing empty elements, eg. N1*ST*Acme Corp~N4*San Francisco*CA*94105~.
+func (p *X12Party) Segments() (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	segment := func(id string, elems ...string) {
+		if s := strings.TrimRight(strings.Join(elems, "*"), "*"); s != "" || id == "N1" {
+			fmt.Fprintf(&b, "%s*%s~", id, s)
+		}
+	}
+	segment("N1", p.EntityCode, p.Name, p.IDQualifier, p.ID)
+	segment("N2", p.AdditionalNames...)
+	for i := 0; i < len(p.AddressLines); i += 2 {
+		segment("N3", p.AddressLines[i:min(i+2, len(p.AddressLines))]...)
+	}
+	segment("N4", p.City, p.State, p.PostalCode, p.Country, p.LocationQualifier, p.LocationID, p.CountrySubdivision)
+	return b.String(), nil
+}
+
+// ParseX12Party parses the segments of a party loop, starting with N1, with the element separator *
+// and the segment terminator ~, and validates it, see X12Party.Validate. Line breaks between segments are ignored.
+func ParseX12Party(segments string) (*X12Party, error) {
+	p := &X12Party{}
+	var ids []string
+	for _, seg := range strings.Split(segments, "~") {
+		seg = strings.TrimSpace(seg)
+		if seg == "" {
+			continue
+		}
+		elems := strings.Split(seg, "*")
+		id, elems := elems[0], elems[1:]
+		limit := map[string]int{"N1": 4, "N2": 2, "N3": 2, "N4": 7}[id]
+		switch {
+		case limit == 0:
+			return nil, fmt.Errorf("%w: unexpected %s segment", ErrInvalidX12, id)
+		case len(ids) == 0 && id != "N1":
+			return nil, fmt.Errorf("%w: loop starts with %s, not N1", ErrInvalidX12, id)
+		case len(ids) > 0 && (id == "N1" || id < ids[len(ids)-1] || id != "N3" && id == ids[len(ids)-1]):
+			return nil, fmt.Errorf("%w: %s after %s", ErrInvalidX12, id, ids[len(ids)-1])
+		case len(elems) > limit:
+			return nil, fmt.Errorf("%w: %s has %d elements, the maximum is %d", ErrInvalidX12, id, len(elems), limit)
+		}
+		ids = append(ids, id)
+		for i := range elems {
+			elems[i] = strings.TrimSpace(elems[i])
+		}
+		elems = append(elems, make([]string, limit-len(elems))...)
+		switch id {
+		case "N1":
+			p.EntityCode, p.Name, p.IDQualifier, p.ID = elems[0], elems[1], elems[2], elems[3]
+		case "N2":
+			p.AdditionalNames = nonEmpty(elems)
+		case "N3":
+			p.AddressLines = append(p.AddressLines, nonEmpty(elems)...)
+		case "N4":
+			p.City, p.State, p.PostalCode, p.Country = elems[0], elems[1], elems[2], elems[3]
+			p.LocationQualifier, p.LocationID, p.CountrySubdivision = elems[4], elems[5], elems[6]
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: no N1 segment", ErrInvalidX12)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ToX12 converts the name and the address of a party to a loop with the entity identifier code,
+// eg. ST for the ship to party, and validates it, see X12Party.Validate.
+//
+// The first line of the name goes to N102 and the others to N2, see NameDetails.Lines. The premise, street,
+// house number, unit and post box become the address lines, see ToSchemaOrg. The administrative area goes
+// to the state for addresses in the US and CA, and to the country subdivision otherwise.
+// US ZIP+4 codes are written without their hyphen, eg. 941051234.
+// Addresses made of address lines keep their lines, but for a line of type Country which becomes the country.
+//
+// The report lists what the loop cannot carry, eg. the dependent locality and the name of the country.
+// An administrative area which is not a code and has no known code, eg. Bavaria in the US, is reported rather
+// than written to the state. On error, the loop is nil and the report still lists the losses.
+func ToX12(entityCode string, name *NameDetails, a *AddressDetails) (*X12Party, Report, error) {
+	p := &X12Party{EntityCode: entityCode}
+	if lines := name.Lines(); len(lines) > 0 {
+		p.Name, p.AdditionalNames = lines[0], lines[1:]
+	}
+	var r Report
+	if a != nil {
+		f := newFlattener(a)
+		flat := f.flat
+		p.City = flat[ComponentLocality]
+		p.Country = flat[ComponentCountryCode]
+		sep := "-"
+		if p.Country == "US" || p.Country == "USA" {
+			sep = ""
+		}
+		p.PostalCode = joinNonEmpty([]string{flat[ComponentPostalCode], flat[ComponentPostalCodeExtension]}, sep)
+		carried := []Component{
+			ComponentLocality, ComponentPostalCode, ComponentPostalCodeExtension, ComponentCountryCode,
+		}
+		if a.AddressLines != nil {
+			for i, l := range *a.AddressLines {
+				carried = append(carried, AddressLineComponent(i+1))
+				switch {
+				case l == nil || l.Text == "":
+				case strings.EqualFold(l.AttrType, "Country") && p.Country == "":
+					p.Country = strings.ToUpper(l.Text)
+					f.use(l, "AttrType")
+				default:
+					p.AddressLines = append(p.AddressLines, l.Text)
+				}
+			}
+		} else {
+			var c []Component
+			p.AddressLines, c = flat.streetLines()
+			carried = append(carried, c...)
+			if box := flat[ComponentPostBox]; box != "" {
+				p.AddressLines = append(p.AddressLines, "PO Box "+box)
+				carried = append(carried, ComponentPostBox)
+			}
+		}
+		switch area := flat[ComponentAdminArea]; p.Country {
+		case "", "US", "USA", "CA", "CAN":
+			if code, ok := x12StateCode(p.Country, area); ok {
+				p.State = code
+				carried = append(carried, ComponentAdminArea)
+			}
+		default:
+			p.CountrySubdivision = area
+			carried = append(carried, ComponentAdminArea)
+		}
+		r = f.dropped(carried...)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, r, err
+	}
+	return p, r, nil
+}
+
+// x12StateCode returns the code of the state or province of an administrative area in the US or CA,
+// eg. CA for California or ON for Ontario, or the area itself when it is a 2 letter code already.
+// It reports false when the area has no code, eg. Bavaria.
+func x12StateCode(country, area string) (string, bool) {
+	if area == "" || utf8.RuneCountInString(area) == 2 {
+		return area, true
+	}
+	key := strings.ToUpper(strings.Join(strings.Fields(area), " "))
+	var code string
+	switch country {
+	case "US", "USA":
+		code = usStateCodes[key]
+	case "CA", "CAN":
+		code = caProvinceCodes[key]
+	default:
+		if code = usStateCodes[key]; code == "" {
+			code = caProvinceCodes[key]
+		}
+	}
+	return code, code != ""
+}
+
+// usStateCodes maps the upper case names of the US states, district and territories to their USPS codes.
+var usStateCodes = map[string]string{
+	"ALABAMA": "AL", "ALASKA": "AK", "ARIZONA": "AZ", "ARKANSAS": "AR", "CALIFORNIA": "CA", "COLORADO": "CO",
+	"CONNECTICUT": "CT", "DELAWARE": "DE", "DISTRICT OF COLUMBIA": "DC", "FLORIDA": "FL", "GEORGIA": "GA",
+	"HAWAII": "HI", "IDAHO": "ID", "ILLINOIS": "IL", "INDIANA": "IN", "IOWA": "IA", "KANSAS": "KS",
+	"KENTUCKY": "KY", "LOUISIANA": "LA", "MAINE": "ME", "MARYLAND": "MD", "MASSACHUSETTS": "MA",
+	"MICHIGAN": "MI", "MINNESOTA": "MN", "MISSISSIPPI": "MS", "MISSOURI": "MO", "MONTANA": "MT",
+	"NEBRASKA": "NE", "NEVADA": "NV", "NEW HAMPSHIRE": "NH", "NEW JERSEY": "NJ", "NEW MEXICO": "NM",
+	"NEW YORK": "NY", "NORTH CAROLINA": "NC", "NORTH DAKOTA": "ND", "OHIO": "OH", "OKLAHOMA": "OK",
+	"OREGON": "OR", "PENNSYLVANIA": "PA", "RHODE ISLAND": "RI", "SOUTH CAROLINA": "SC", "SOUTH DAKOTA": "SD",
+	"TENNESSEE": "TN", "TEXAS": "TX", "UTAH": "UT", "VERMONT": "VT", "VIRGINIA": "VA", "WASHINGTON": "WA",
+	"WEST VIRGINIA": "WV", "WISCONSIN": "WI", "WYOMING": "WY", "AMERICAN SAMOA": "AS", "GUAM": "GU",
+	"NORTHERN MARIANA ISLANDS": "MP", "PUERTO RICO": "PR", "U.S. VIRGIN ISLANDS": "VI", "VIRGIN ISLANDS": "VI",
+}
+
+// caProvinceCodes maps the upper case names of the Canadian provinces and territories to their Canada Post codes.
+var caProvinceCodes = map[string]string{
+	"ALBERTA": "AB", "BRITISH COLUMBIA": "BC", "MANITOBA": "MB", "NEW BRUNSWICK": "NB",
+	"NEWFOUNDLAND AND LABRADOR": "NL", "NORTHWEST TERRITORIES": "NT", "NOVA SCOTIA": "NS", "NUNAVUT": "NU",
+	"ONTARIO": "ON", "PRINCE EDWARD ISLAND": "PE", "QUEBEC": "QC", "QUÉBEC": "QC", "SASKATCHEWAN": "SK",
+	"YUKON": "YT",
+}
+
+// FromX12 converts a party loop to the name and the address of the party, either of which is nil when empty.
+//
+// N102 and the additional names become name lines. The address lines are parsed on a best-effort basis,
+// see FromSchemaOrg, a line such as PO Box 123 giving the post box, and the state, or else the country subdivision,
+// becomes the administrative area. A loop with address lines and a country only is converted to address lines,
+// the country being kept in a last line of type Country.
+//
+// The report lists the elements that were not converted, as JSON Pointers to the fields of the loop,
+// eg. /LocationID. The party identification is not part of the name nor of the address, and is never reported.
+func FromX12(p *X12Party) (*NameDetails, *AddressDetails, Report) {
+	if p == nil {
+		return nil, nil, Report{}
+	}
+	var r Report
+	name := NameFromLines(append([]string{p.Name}, p.AdditionalNames...)...)
+	area := strings.TrimSpace(p.State)
+	if area == "" {
+		area = strings.TrimSpace(p.CountrySubdivision)
+	} else if p.CountrySubdivision != "" {
+		r.Dropped = append(r.Dropped, "/CountrySubdivision")
+	}
+	if p.LocationQualifier != "" {
+		r.Dropped = append(r.Dropped, "/LocationQualifier")
+	}
+	if p.LocationID != "" {
+		r.Dropped = append(r.Dropped, "/LocationID")
+	}
+	sort.Strings(r.Dropped)
+	country := strings.ToUpper(strings.TrimSpace(p.Country))
+	lines := nonEmpty(p.AddressLines)
+	structured := p.City != "" || area != "" || p.PostalCode != ""
+	var flat Flat
+	switch {
+	case structured:
+		var box string
+		lines = slices.DeleteFunc(lines, func(l string) bool {
+			m := postBoxRE.FindStringSubmatch(l)
+			if m != nil && box == "" {
+				box = m[1]
+				return true
+			}
+			return false
+		})
+		flat = parseStreetLines(lines, country)
+		flat[ComponentPostBox] = box
+		flat[ComponentLocality] = strings.TrimSpace(p.City)
+		flat[ComponentAdminArea] = area
+		flat[ComponentPostalCode] = strings.TrimSpace(p.PostalCode)
+		flat[ComponentCountryCode] = country
+		if country == "" || country == "US" || country == "USA" {
+			for _, re := range []*regexp.Regexp{zipPlus4RE, zipNineRE} {
+				if m := re.FindStringSubmatch(flat[ComponentPostalCode]); m != nil {
+					flat[ComponentPostalCode], flat[ComponentPostalCodeExtension] = m[1], m[2]
+				}
+			}
+		}
+	case len(lines) > 0 || country != "":
+		flat = Flat{}
+		for i, l := range lines {
+			flat[AddressLineComponent(i+1)] = l
+		}
+	default:
+		return name, nil, r
+	}
+	a := flat.build()
+	if !structured && country != "" {
+		if a.AddressLines == nil {
+			a.AddressLines = &AddressLines{}
+		}
+		*a.AddressLines = append(*a.AddressLines, &AddressLine{AttrType: "Country", Text: country})
+	}
+	return name, a, r
+}
@@ -0,0 +1,189 @@
+package xal
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestX12Segments(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        *X12Party
+		segments string
+	}{
+		{"party", &X12Party{
+			EntityCode: "ST", Name: "Acme Corp", IDQualifier: "92", ID: "1234", AddressLines: []string{"1 Market St", "Suite 100"},
+			City: "San Francisco", State: "CA", PostalCode: "941051234", Country: "US",
+		}, "N1*ST*Acme Corp*92*1234~N3*1 Market St*Suite 100~N4*San Francisco*CA*941051234*US~"},
+		{"entity code only", &X12Party{EntityCode: "BT"}, "N1*BT~"},
+		{"additional names", &X12Party{EntityCode: "BT", Name: "Acme", AdditionalNames: []string{"Accounts", "Payables"}}, "N1*BT*Acme~N2*Accounts*Payables~"},
+		{"two N3 segments", &X12Party{EntityCode: "ST", AddressLines: []string{"a", "b", "c"}}, "N1*ST~N3*a*b~N3*c~"},
+		{"subdivision", &X12Party{EntityCode: "ST", City: "Munich", Country: "DE", CountrySubdivision: "BY"}, "N1*ST~N4*Munich***DE***BY~"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Segments()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.segments {
+				t.Errorf("Segments() = %s, want %s", got, tt.segments)
+			}
+			p, err := ParseX12Party(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(p, tt.p) {
+				t.Errorf("ParseX12Party(%s) = %+v, want %+v", got, p, tt.p)
+			}
+		})
+	}
+}
+
+func TestParseX12Party(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments string
+		want     *X12Party
+		err      error
+	}{
+		{"line breaks", "N1*ST*Acme~\nN4*Oslo**0150*NO~\n", &X12Party{EntityCode: "ST", Name: "Acme", City: "Oslo", PostalCode: "0150", Country: "NO"}, nil},
+		{"empty", "", nil, ErrInvalidX12},
+		{"not N1 first", "N3*1 Market St~N1*ST~", nil, ErrInvalidX12},
+		{"unexpected segment", "N1*ST~PER*IC*Jane~", nil, ErrInvalidX12},
+		{"out of order", "N1*ST~N4*Oslo~N3*Main Street~", nil, ErrInvalidX12},
+		{"repeated N4", "N1*ST~N4*Oslo~N4*Bergen~", nil, ErrInvalidX12},
+		{"second N1", "N1*ST~N1*BT~", nil, ErrInvalidX12},
+		{"too many elements", "N1*ST*Acme*92*1234*X~", nil, ErrInvalidX12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseX12Party(tt.segments)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestX12Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *X12Party
+		want []string // paths of the errors
+	}{
+		{"valid", &X12Party{EntityCode: "ST", Name: strings.Repeat("x", 60)}, nil},
+		{"no entity code", &X12Party{}, []string{"/N1/N101"}},
+		{"name too long", &X12Party{EntityCode: "ST", Name: strings.Repeat("x", 61)}, []string{"/N1/N102"}},
+		{"delimiter", &X12Party{EntityCode: "ST", Name: "Smith*Sons"}, []string{"/N1/N102"}},
+		{"component separator", &X12Party{EntityCode: "ST", AddressLines: []string{"a", "b", "Unit:4"}}, []string{"/N3/1/N301"}},
+		{"too many address lines", &X12Party{EntityCode: "ST", AddressLines: []string{"a", "b", "c", "d", "e"}}, []string{"/N3"}},
+		{"too many additional names", &X12Party{EntityCode: "ST", AdditionalNames: []string{"a", "b", "c"}}, []string{"/N2"}},
+		{"city too short", &X12Party{EntityCode: "ST", City: "X"}, []string{"/N4/N401"}},
+		{"state", &X12Party{EntityCode: "ST", State: "Calif"}, []string{"/N4/N402"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorPaths(tt.p.Validate())
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("Validate() failed on %v, want %v", got, tt.want)
+			}
+			if _, err := tt.p.Segments(); (err != nil) != (tt.want != nil) {
+				t.Errorf("Segments() error %v", err)
+			}
+		})
+	}
+}
+
+func TestX12RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *X12Party
+	}{
+		{"US", &X12Party{
+			EntityCode: "ST", Name: "Acme Corp", AddressLines: []string{"1 Market St", "PO Box 12"},
+			City: "San Francisco", State: "CA", PostalCode: "941051234", Country: "US",
+		}},
+		{"subdivision", &X12Party{EntityCode: "ST", City: "Munich", PostalCode: "80331", Country: "DE", CountrySubdivision: "BY"}},
+		{"address lines", &X12Party{EntityCode: "ST", AddressLines: []string{"1 Market St", "San Francisco"}, Country: "US"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, a, r := FromX12(tt.p)
+			if len(r.Dropped) > 0 {
+				t.Fatalf("FromX12 dropped %v", r.Dropped)
+			}
+			got, r, err := ToX12(tt.p.EntityCode, name, a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(r.Dropped) > 0 {
+				t.Fatalf("ToX12 dropped %v", r.Dropped)
+			}
+			want, _ := tt.p.Segments()
+			if s, _ := got.Segments(); s != want {
+				t.Errorf("got %s, want %s", s, want)
+			}
+		})
+	}
+}
+
+func TestX12Report(t *testing.T) {
+	p := &X12Party{
+		EntityCode: "ST", City: "Toronto", State: "ON", Country: "CA", CountrySubdivision: "ON",
+		LocationQualifier: "1", LocationID: "123",
+	}
+	if _, _, r := FromX12(p); strings.Join(r.Dropped, " ") != "/CountrySubdivision /LocationID /LocationQualifier" {
+		t.Errorf("FromX12 dropped %v", r.Dropped)
+	}
+
+	a := &AddressDetails{Country: &Country{
+		CountryNameCode: []*CountryNameCode{{Text: "US"}},
+		AdministrativeArea: &AdministrativeArea{
+			AdministrativeAreaName: []*AdministrativeAreaName{{Text: "Bavaria"}},
+			Locality:               &Locality{LocalityName: []*LocalityName{{Text: "Springfield"}}},
+		},
+	}}
+	got, r, err := ToX12("ST", nil, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != "" || got.CountrySubdivision != "" {
+		t.Errorf("ToX12 wrote the administrative area to %+v", got)
+	}
+	if len(r.Dropped) != 1 {
+		t.Errorf("ToX12 dropped %v, want the administrative area", r.Dropped)
+	}
+
+	a.Country.AdministrativeArea.AdministrativeAreaName[0].Text = "California"
+	if got, _, _ := ToX12("ST", nil, a); got == nil || got.State != "CA" {
+		t.Errorf("ToX12 wrote the state %+v, want CA", got)
+	}
+}
+
+// errorPaths returns the paths of the errors of a ValidationErrors, including their fields.
+func errorPaths(err error) []string {
+	var paths []string
+	var errs ValidationErrors
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			p := e.Path
+			if e.Field != "" {
+				p = p.child(e.Field)
+			}
+			paths = append(paths, p.String())
+		}
+	}
+	return paths
+}
@@ -1,496 +1,872 @@
-/*Package xal defines defines structs that conform to the OASIS xAL spec
+// Code generated by xalgen from xAL.xsd; DO NOT EDIT.
 
-Specification
-
-You can find and download the spec here: https://www.oasis-open.org/committees/ciq/download.html
-
-The entry point for a XAL address is the top level XAL struct.
-
-Fields annotated with Attr are what used to be attribute fields in the XML formatted specification.
-*/
 package xal
 
 type (
 	// XAL - Root element for a list of addresses
 	XAL struct {
-		AttrVersion    string            `json:"attr_version,omitempty"` // Specific to DTD to specify the version number of DTD
-		AddressDetails []*AddressDetails `json:"address_details,omitempty"`
+		AttrVersion    string            `json:"attr_version,omitempty" xml:"Version,attr,omitempty"` // Specific to DTD to specify the version number of DTD
+		AddressDetails []*AddressDetails `json:"address_details,omitempty" xml:"AddressDetails,omitempty"`
 	}
 
-	// AddressDetails - This container defines the details of the address.
-	// Can define multiple addresses including tracking address history
+	// Address - Address as one line of free text
+	Address struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Postal, residential, corporate, etc
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// AddressDetails - This container defines the details of the address. Can define multiple addresses including
+	// tracking address history
 	AddressDetails struct {
-		AttrAddressType    string              `json:"attr_address_type,omitempty"`    // maxLength=23
-		AttrCurrentStatus  string              `json:"attr_current_status,omitempty"`  // maxLength=10
-		AttrUsage          string              `json:"attr_usage,omitempty"`           // maxLength=6
-		AttrValidFromDate  string              `json:"attr_valid_from_date,omitempty"` // maxLength=11
-		AttrValidToDate    string              `json:"attr_valid_to_date,omitempty"`   // maxLength=13
-		AddressLines       *AddressLines       `json:"address_lines,omitempty"`
-		AdministrativeArea *AdministrativeArea `json:"administrative_area,omitempty"`
-		Country            *Country            `json:"country,omitempty"`
-		Locality           *Locality           `json:"locality,omitempty"`
-	}
-
-	// AddressLine - Free format address representation.
-	// An address can have more than one line.
-	// The order of the AddressLine elements must be preserved.
+		AttrAddressType       string                 `json:"attr_address_type,omitempty" xml:"AddressType,attr,omitempty"`              // maxLength=23; Type of address. Example: Postal, residential,business, primary, secondary, etc
+		AttrCurrentStatus     string                 `json:"attr_current_status,omitempty" xml:"CurrentStatus,attr,omitempty"`          // maxLength=10; Moved, Living, Investment, Deceased, etc..
+		AttrValidFromDate     string                 `json:"attr_valid_from_date,omitempty" xml:"ValidFromDate,attr,omitempty"`         // maxLength=11; Start Date of the validity of address
+		AttrValidToDate       string                 `json:"attr_valid_to_date,omitempty" xml:"ValidToDate,attr,omitempty"`             // maxLength=13; End date of the validity of address
+		AttrUsage             string                 `json:"attr_usage,omitempty" xml:"Usage,attr,omitempty"`                           // maxLength=6; Communication, Contact, etc.
+		AttrCode              string                 `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                             // Used by postal services to encode the name of the element.
+		AttrAddressDetailsKey string                 `json:"attr_address_details_key,omitempty" xml:"AddressDetailsKey,attr,omitempty"` // Key identifier for the element for not reinforced references from other elements. Not required to be unique for the document to be valid, but application may get confused if not unique. Extend this schema adding unique contraint if needed.
+		PostalServiceElements *PostalServiceElements `json:"postal_service_elements,omitempty" xml:"PostalServiceElements,omitempty"`
+		Address               *Address               `json:"address,omitempty" xml:"Address,omitempty"`                        // choice
+		AddressLines          *AddressLines          `json:"address_lines,omitempty" xml:"AddressLines>AddressLine,omitempty"` // choice
+		Country               *Country               `json:"country,omitempty" xml:"Country,omitempty"`                        // choice
+		AdministrativeArea    *AdministrativeArea    `json:"administrative_area,omitempty" xml:"AdministrativeArea,omitempty"` // choice
+		Locality              *Locality              `json:"locality,omitempty" xml:"Locality,omitempty"`                      // choice
+		Thoroughfare          *Thoroughfare          `json:"thoroughfare,omitempty" xml:"Thoroughfare,omitempty"`              // choice
+	}
+
+	// AddressIdentifier - A unique identifier of an address assigned by postal authorities. Example: DPID in
+	// Australia
+	AddressIdentifier struct {
+		AttrIdentifierType string `json:"attr_identifier_type,omitempty" xml:"IdentifierType,attr,omitempty"` // Type of identifier. eg. DPID as in Australia
+		AttrType           string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode           string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text               string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// AddressLatitude - Latitude of delivery address
+	AddressLatitude struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// AddressLatitudeDirection - Latitude direction of delivery address;N = North and S = South
+	AddressLatitudeDirection struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// AddressLine - Free format address representation. An address can have more than one line. The order of the
+	// AddressLine elements must be preserved.
 	AddressLine struct {
-		AttrType string `json:"attr_type,omitempty"` // Defines the type of address line. eg. Street, Address Line 1, etc.
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Defines the type of address line. eg. Street, Address Line 1, etc.
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// AddressLines - Container for Address lines
 	AddressLines []*AddressLine
 
-	// AdministrativeArea - Examples of administrative areas are provinces counties,
-	// special regions (such as "Rijnmond"), etc.
+	// AddressLongitude - Longtitude of delivery address
+	AddressLongitude struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// AddressLongitudeDirection - Longtitude direction of delivery address;N=North and S=South
+	AddressLongitudeDirection struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// AdministrativeArea - Examples of administrative areas are provinces counties, special regions (such as
+	// "Rijnmond"), etc.
 	AdministrativeArea struct {
-		AttrType               string                    `json:"attr_type,omitempty"`       // maxLength=8; Province or State or County or Kanton, etc
-		AttrUsageType          string                    `json:"attr_usage_type,omitempty"` // Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
-		AttrIndicator          string                    `json:"attr_indicator,omitempty"`  // Erode (Dist) where (Dist) is the Indicator
-		AdministrativeAreaName []*AdministrativeAreaName `json:"administrative_area_name,omitempty"`
-		Locality               *Locality                 `json:"locality,omitempty"`
+		AttrType               string                    `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`            // maxLength=8; Province or State or County or Kanton, etc
+		AttrUsageType          string                    `json:"attr_usage_type,omitempty" xml:"UsageType,attr,omitempty"` // Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
+		AttrIndicator          string                    `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`  // Erode (Dist) where (Dist) is the Indicator
+		AddressLine            []*AddressLine            `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		AdministrativeAreaName []*AdministrativeAreaName `json:"administrative_area_name,omitempty" xml:"AdministrativeAreaName,omitempty"`
+		SubAdministrativeArea  *SubAdministrativeArea    `json:"sub_administrative_area,omitempty" xml:"SubAdministrativeArea,omitempty"`
+		Locality               *Locality                 `json:"locality,omitempty" xml:"Locality,omitempty"`      // choice
+		PostOffice             *PostOffice               `json:"post_office,omitempty" xml:"PostOffice,omitempty"` // choice
+		PostalCode             *PostalCode               `json:"postal_code,omitempty" xml:"PostalCode,omitempty"` // choice
 	}
 
 	// AdministrativeAreaName - Name of the administrative area. eg. MI in USA, NSW in Australia
 	AdministrativeAreaName struct {
-		AttrType string `json:"attr_type,omitempty"` // maxLength=12
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // maxLength=12
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// Barcode - Required for some postal services
+	Barcode struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// BuildingName - Specification of the name of a building.
+	// BuildingName - Name of the building
 	BuildingName struct {
-		AttrType           string `json:"attr_type,omitempty"`
-		AttrTypeOccurrence string `json:"attr_type_occurrence,omitempty"` // Occurrence of the building name before/after the type. eg. EGIS BUILDING where name appears before type
-		AttrCode           string `json:"attr_code,omitempty"`            // Used by postal services to encode the name of the element.
-		Text               string `json:"text,omitempty"`
+		AttrType           string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrTypeOccurrence string `json:"attr_type_occurrence,omitempty" xml:"TypeOccurrence,attr,omitempty"` // enum=Before|After; Occurrence of the building name before/after the type. eg. EGIS BUILDING where name appears before type
+		AttrCode           string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                      // Used by postal services to encode the name of the element.
+		Text               string `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// Country - Specification of a country
 	Country struct {
-		AdministrativeArea *AdministrativeArea `json:"administrative_area,omitempty"`
-		CountryName        *CountryName        `json:"country_name,omitempty"`
-		CountryNameCode    *CountryNameCode    `json:"country_name_code,omitempty"`
-		Locality           *Locality           `json:"locality,omitempty"`
-		Thoroughfare       *Thoroughfare       `json:"thoroughfare,omitempty"`
+		AddressLine        []*AddressLine      `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		CountryNameCode    []*CountryNameCode  `json:"country_name_code,omitempty" xml:"CountryNameCode,omitempty"`
+		CountryName        []*CountryName      `json:"country_name,omitempty" xml:"CountryName,omitempty"`
+		AdministrativeArea *AdministrativeArea `json:"administrative_area,omitempty" xml:"AdministrativeArea,omitempty"` // choice
+		Locality           *Locality           `json:"locality,omitempty" xml:"Locality,omitempty"`                      // choice
+		Thoroughfare       *Thoroughfare       `json:"thoroughfare,omitempty" xml:"Thoroughfare,omitempty"`              // choice
 	}
 
 	// CountryName - Specification of the name of a country.
 	CountryName struct {
-		AttrType string `json:"attr_type,omitempty"` // Old name, new name, etc
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Old name, new name, etc
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// CountryNameCode - A country code according to the specified scheme
-	//
-	// Country code scheme possible values, but not limited to:
-	//  iso.3166-2,
-	//  iso.3166-3 for two and three character country codes.
 	CountryNameCode struct {
-		AttrScheme string `json:"attr_scheme,omitempty"`
-		AttrCode   string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text       string `json:"text,omitempty"`
+		AttrScheme string `json:"attr_scheme,omitempty" xml:"Scheme,attr,omitempty"` // Country code scheme possible values, but not limited to: iso.3166-2, iso.3166-3 for two and three character country codes.
+		AttrCode   string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`     // Used by postal services to encode the name of the element.
+		Text       string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// Locality - Locality is one level lower than administrative area.
-	// Eg.: cities, reservations and any other built-up areas.
-	//
-	// AttrUsageType: Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
-	Locality struct {
-		AttrType          string             `json:"attr_type,omitempty"` // maxLength=8; Possible values not limited to: City, IndustrialEstate, etc
-		AttrUsageType     string             `json:"attr_usage_type,omitempty"`
-		AttrIndicator     string             `json:"attr_indicator,omitempty"` // Erode (Dist) where (Dist) is the Indicator
-		DependentLocality *DependentLocality `json:"dependent_locality,omitempty"`
-		LargeMailUser     *LargeMailUser     `json:"large_mail_user,omitempty"`
-		LocalityName      []*LocalityName    `json:"locality_name,omitempty"`
-		PostBox           *PostBox           `json:"post_box,omitempty"`
-		PostOffice        *PostOffice        `json:"post_office,omitempty"`
-		PostalCode        *PostalCode        `json:"postal_code,omitempty"`
-		Premise           *Premise           `json:"premise,omitempty"`
-		Thoroughfare      *Thoroughfare      `json:"thoroughfare,omitempty"`
-	}
-
-	// LocalityName - Name of the locality
-	LocalityName struct {
-		AttrType string `json:"attr_type,omitempty"` // maxLength=12
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
-	}
-
-	// Department - Subdivision in the firm: School of Physics at Victoria University (School of Physics is the department)
+	// Department - Subdivision in the firm: School of Physics at Victoria University (School of Physics is the
+	// department)
 	Department struct {
-		AttrType       string          `json:"attr_type,omitempty"` // School in Physics School, Division in Radiology division of school of physics
-		DepartmentName *DepartmentName `json:"department_name,omitempty"`
+		AttrType       string            `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // School in Physics School, Division in Radiology division of school of physics
+		AddressLine    []*AddressLine    `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		DepartmentName []*DepartmentName `json:"department_name,omitempty" xml:"DepartmentName,omitempty"`
+		MailStop       *MailStop         `json:"mail_stop,omitempty" xml:"MailStop,omitempty"`
+		PostalCode     *PostalCode       `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
 	}
 
 	// DepartmentName - Specification of the name of a department.
 	DepartmentName struct {
-		AttrType string `json:"attr_type,omitempty"`
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
-	}
-
-	// DependentLocality - Dependent localities are Districts within cities/towns, locality divisions,
-	// postal divisions of cities, suburbs, etc.
-	//
-	// DependentLocality is a recursive element,
-	// but no nesting deeper than two exists (Locality-DependentLocality-DependentLocality).
-	//
-	// AttrUsageType: Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
-	//
-	// AttrConnector: "VIA" as in Hill Top VIA Parish where Parish is a locality and Hill Top is a dependent locality
-	//
-	// AttrIndicator: Eg. Erode (Dist) where (Dist) is the Indicator
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// DependentLocality - Dependent localities are Districts within cities/towns, locality divisions, postal
+	// divisions of cities, suburbs, etc. DependentLocality is a recursive element, but no nesting deeper than two
+	// exists (Locality-DependentLocality-DependentLocality).
 	DependentLocality struct {
-		AttrConnector           string                     `json:"attr_connector,omitempty"` // maxLength=25
-		AttrType                string                     `json:"attr_type,omitempty"`      // maxLength=12
-		AttrUsageType           string                     `json:"attr_usage_type,omitempty"`
-		DependentLocality       *DependentLocality         `json:"dependent_locality,omitempty"`
-		DependentLocalityName   []*DependentLocalityName   `json:"dependent_locality_name,omitempty"`
-		DependentLocalityNumber []*DependentLocalityNumber `json:"dependent_locality_number,omitempty"`
-		LargeMailUser           *LargeMailUser             `json:"large_mail_user,omitempty"`
-		PostOffice              *PostOffice                `json:"post_office,omitempty"`
-		Premise                 *Premise                   `json:"premise,omitempty"`
-		Thoroughfare            *Thoroughfare              `json:"thoroughfare,omitempty"`
+		AttrType                string                   `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`            // maxLength=12; City or IndustrialEstate, etc
+		AttrUsageType           string                   `json:"attr_usage_type,omitempty" xml:"UsageType,attr,omitempty"` // Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
+		AttrConnector           string                   `json:"attr_connector,omitempty" xml:"Connector,attr,omitempty"`  // maxLength=25; "VIA" as in Hill Top VIA Parish where Parish is a locality and Hill Top is a dependent locality
+		AttrIndicator           string                   `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`  // Eg. Erode (Dist) where (Dist) is the Indicator
+		AddressLine             []*AddressLine           `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		DependentLocalityName   []*DependentLocalityName `json:"dependent_locality_name,omitempty" xml:"DependentLocalityName,omitempty"`
+		DependentLocalityNumber *DependentLocalityNumber `json:"dependent_locality_number,omitempty" xml:"DependentLocalityNumber,omitempty"`
+		PostBox                 *PostBox                 `json:"post_box,omitempty" xml:"PostBox,omitempty"`              // choice
+		LargeMailUser           *LargeMailUser           `json:"large_mail_user,omitempty" xml:"LargeMailUser,omitempty"` // choice
+		PostOffice              *PostOffice              `json:"post_office,omitempty" xml:"PostOffice,omitempty"`        // choice
+		PostalRoute             *PostalRoute             `json:"postal_route,omitempty" xml:"PostalRoute,omitempty"`      // choice
+		Thoroughfare            *Thoroughfare            `json:"thoroughfare,omitempty" xml:"Thoroughfare,omitempty"`
+		Premise                 *Premise                 `json:"premise,omitempty" xml:"Premise,omitempty"`
+		DependentLocality       *DependentLocality       `json:"dependent_locality,omitempty" xml:"DependentLocality,omitempty"`
+		PostalCode              *PostalCode              `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
 	}
 
 	// DependentLocalityName - Name of the dependent locality
 	DependentLocalityName struct {
-		AttrType string `json:"attr_type,omitempty"` // maxLength=12
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // maxLength=12
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// DependentLocalityNumber - Number of the dependent locality. Some areas are numbered.
-	// Eg. SECTOR 5 in a Suburb as in India or SOI SUKUMVIT 10 as in Thailand
+	// DependentLocalityNumber - Number of the dependent locality. Some areas are numbered. Eg. SECTOR 5 in a
+	// Suburb as in India or SOI SUKUMVIT 10 as in Thailand
 	DependentLocalityNumber struct {
-		AttrNameNumberOccurrence string `json:"attr_name_number_occurrence,omitempty"` // maxLength=6
-		AttrCode                 string `json:"attr_code,omitempty"`                   // Used by postal services to encode the name of the element.
-		Text                     string `json:"text,omitempty"`
+		AttrNameNumberOccurrence string `json:"attr_name_number_occurrence,omitempty" xml:"NameNumberOccurrence,attr,omitempty"` // maxLength=6; enum=Before|After; Eg. SECTOR occurs before 5 in SECTOR 5
+		AttrCode                 string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                   // Used by postal services to encode the name of the element.
+		Text                     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// DependentThoroughfare is related to a street; occurs in GB, IE, ES, PT
+	// DependentThoroughfare - DependentThroughfare is related to a street; occurs in GB, IE, ES, PT
 	DependentThoroughfare struct {
-		AttrType                 string                    `json:"attr_type,omitempty"`
-		ThoroughfareName         *ThoroughfareName         `json:"thoroughfare_name,omitempty"`
-		ThoroughfarePreDirection *ThoroughfarePreDirection `json:"thoroughfare_pre_direction,omitempty"`
-		ThoroughfareTrailingType *ThoroughfareTrailingType `json:"thoroughfare_trailing_type,omitempty"`
-	}
-
-	// LargeMailUser - Specification of a large mail user address.
-	//
-	// Examples of large mail users are postal companies, companies in France with a cedex number,
-	// hospitals and airports with their own post code.
-	//
-	// Large mail user addresses do not have a street name with premise name or premise number
-	// in countries like Netherlands. But they have a POBox and street also in countries like France.
+		AttrType                  string                     `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AddressLine               []*AddressLine             `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		ThoroughfarePreDirection  *ThoroughfarePreDirection  `json:"thoroughfare_pre_direction,omitempty" xml:"ThoroughfarePreDirection,omitempty"`
+		ThoroughfareLeadingType   *ThoroughfareLeadingType   `json:"thoroughfare_leading_type,omitempty" xml:"ThoroughfareLeadingType,omitempty"`
+		ThoroughfareName          []*ThoroughfareName        `json:"thoroughfare_name,omitempty" xml:"ThoroughfareName,omitempty"`
+		ThoroughfareTrailingType  *ThoroughfareTrailingType  `json:"thoroughfare_trailing_type,omitempty" xml:"ThoroughfareTrailingType,omitempty"`
+		ThoroughfarePostDirection *ThoroughfarePostDirection `json:"thoroughfare_post_direction,omitempty" xml:"ThoroughfarePostDirection,omitempty"`
+	}
+
+	// EndorsementLineCode - Directly affects postal service distribution
+	EndorsementLineCode struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// Firm - Specification of a firm, company, organization, etc. It can be specified as part of an address that
+	// contains a street or a postbox. It is therefore different from a large mail user address, which contains no
+	// street.
+	Firm struct {
+		AttrType    string         `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AddressLine []*AddressLine `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		FirmName    []*FirmName    `json:"firm_name,omitempty" xml:"FirmName,omitempty"`
+		Department  []*Department  `json:"department,omitempty" xml:"Department,omitempty"`
+		MailStop    *MailStop      `json:"mail_stop,omitempty" xml:"MailStop,omitempty"`
+		PostalCode  *PostalCode    `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
+	}
+
+	// FirmName - Name of the firm
+	FirmName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// KeyLineCode - Required for some postal services
+	KeyLineCode struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// LargeMailUser - Specification of a large mail user address. Examples of large mail users are postal
+	// companies, companies in France with a cedex number, hospitals and airports with their own post code. Large
+	// mail user addresses do not have a street name with premise name or premise number in countries like
+	// Netherlands. But they have a POBox and street also in countries like France
 	LargeMailUser struct {
-		AttrType                string                   `json:"attr_type,omitempty"` // maxLength=8
-		BuildingName            *BuildingName            `json:"building_name,omitempty"`
-		Department              *Department              `json:"department,omitempty"`
-		LargeMailUserIdentifier *LargeMailUserIdentifier `json:"large_mail_user_identifier,omitempty"`
-		LargeMailUserName       *LargeMailUserName       `json:"large_mail_user_name,omitempty"`
-	}
-
-	// LargeMailUserIdentifier - Specification of the identification number of a large mail user.
-	//
-	// An example are the Cedex codes in France.
+		AttrType                string                   `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // maxLength=8
+		AddressLine             []*AddressLine           `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		LargeMailUserName       []*LargeMailUserName     `json:"large_mail_user_name,omitempty" xml:"LargeMailUserName,omitempty"`
+		LargeMailUserIdentifier *LargeMailUserIdentifier `json:"large_mail_user_identifier,omitempty" xml:"LargeMailUserIdentifier,omitempty"`
+		BuildingName            []*BuildingName          `json:"building_name,omitempty" xml:"BuildingName,omitempty"`
+		Department              *Department              `json:"department,omitempty" xml:"Department,omitempty"`
+		PostBox                 *PostBox                 `json:"post_box,omitempty" xml:"PostBox,omitempty"`
+		Thoroughfare            *Thoroughfare            `json:"thoroughfare,omitempty" xml:"Thoroughfare,omitempty"`
+		PostalCode              *PostalCode              `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
+	}
+
+	// LargeMailUserIdentifier - Specification of the identification number of a large mail user. An example are
+	// the Cedex codes in France.
 	LargeMailUserIdentifier struct {
-		AttrType      string `json:"attr_type,omitempty"`      // maxLength=14
-		AttrIndicator string `json:"attr_indicator,omitempty"` // eg. Building 429 in which Building is the Indicator
-		AttrCode      string `json:"attr_code,omitempty"`      // Used by postal services to encode the name of the element.
-		Text          string `json:"text,omitempty"`
+		AttrType      string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`           // maxLength=14; CEDEX Code
+		AttrIndicator string `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"` // eg. Building 429 in which Building is the Indicator
+		AttrCode      string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`           // Used by postal services to encode the name of the element.
+		Text          string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// LargeMailUserName - Name of the large mail user.
-	//
-	// eg. Smith Ford International airport
+	// LargeMailUserName - Name of the large mail user. eg. Smith Ford International airport
 	LargeMailUserName struct {
-		AttrType string `json:"attr_type,omitempty"` // Airport, Hospital, etc
-		AttrCode string `json:"attr_code,omitempty"`
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Airport, Hospital, etc
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// Locality - Locality is one level lower than adminisstrative area. Eg.: cities, reservations and any other
+	// built-up areas.
+	Locality struct {
+		AttrType          string             `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`            // maxLength=8; Possible values not limited to: City, IndustrialEstate, etc
+		AttrUsageType     string             `json:"attr_usage_type,omitempty" xml:"UsageType,attr,omitempty"` // Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
+		AttrIndicator     string             `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`  // Erode (Dist) where (Dist) is the Indicator
+		AddressLine       []*AddressLine     `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		LocalityName      []*LocalityName    `json:"locality_name,omitempty" xml:"LocalityName,omitempty"`
+		PostBox           *PostBox           `json:"post_box,omitempty" xml:"PostBox,omitempty"`              // choice
+		LargeMailUser     *LargeMailUser     `json:"large_mail_user,omitempty" xml:"LargeMailUser,omitempty"` // choice
+		PostOffice        *PostOffice        `json:"post_office,omitempty" xml:"PostOffice,omitempty"`        // choice
+		PostalRoute       *PostalRoute       `json:"postal_route,omitempty" xml:"PostalRoute,omitempty"`      // choice
+		Thoroughfare      *Thoroughfare      `json:"thoroughfare,omitempty" xml:"Thoroughfare,omitempty"`
+		Premise           *Premise           `json:"premise,omitempty" xml:"Premise,omitempty"`
+		DependentLocality *DependentLocality `json:"dependent_locality,omitempty" xml:"DependentLocality,omitempty"`
+		PostalCode        *PostalCode        `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
+	}
+
+	// LocalityName - Name of the locality
+	LocalityName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // maxLength=12
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// MailStop - A MailStop is where the the mail is delivered to within a premise/subpremise/firm or a facility.
+	MailStop struct {
+		AttrType       string          `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AddressLine    []*AddressLine  `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		MailStopName   *MailStopName   `json:"mail_stop_name,omitempty" xml:"MailStopName,omitempty"`
+		MailStopNumber *MailStopNumber `json:"mail_stop_number,omitempty" xml:"MailStopNumber,omitempty"`
 	}
 
-	// PostBox - Specification of a postbox like mail delivery point.
-	//
-	// Only a single postbox number can be specified.
-	//
-	// Examples of postboxes are POBox, free mail numbers, etc.
+	// MailStopName - Name of the the Mail Stop. eg. MSP, MS, etc
+	MailStopName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// MailStopNumber - Number of the Mail stop. eg. 123 in MS 123
+	MailStopNumber struct {
+		AttrNameNumberSeparator string `json:"attr_name_number_separator,omitempty" xml:"NameNumberSeparator,attr,omitempty"` // "-" in MS-123
+		AttrCode                string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                 // Used by postal services to encode the name of the element.
+		Text                    string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostBox - Specification of a postbox like mail delivery point. Only a single postbox number can be
+	// specified. Examples of postboxes are POBox, free mail numbers, etc.
 	PostBox struct {
-		AttrType      string         `json:"attr_type,omitempty"`      // maxLength=5
-		AttrIndicator string         `json:"attr_indicator,omitempty"` // LOCKED BAG NO:1234 where the Indicator is NO: and Type is LOCKED BAG
-		PostBoxNumber *PostBoxNumber `json:"post_box_number,omitempty"`
-		PostalCode    *PostalCode    `json:"postal_code,omitempty"`
+		AttrType               string                  `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`           // maxLength=5; Possible values are, not limited to: POBox and Freepost.
+		AttrIndicator          string                  `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"` // LOCKED BAG NO:1234 where the Indicator is NO: and Type is LOCKED BAG
+		AddressLine            []*AddressLine          `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PostBoxNumber          *PostBoxNumber          `json:"post_box_number,omitempty" xml:"PostBoxNumber,omitempty"`
+		PostBoxNumberPrefix    *PostBoxNumberPrefix    `json:"post_box_number_prefix,omitempty" xml:"PostBoxNumberPrefix,omitempty"`
+		PostBoxNumberSuffix    *PostBoxNumberSuffix    `json:"post_box_number_suffix,omitempty" xml:"PostBoxNumberSuffix,omitempty"`
+		PostBoxNumberExtension *PostBoxNumberExtension `json:"post_box_number_extension,omitempty" xml:"PostBoxNumberExtension,omitempty"`
+		Firm                   *Firm                   `json:"firm,omitempty" xml:"Firm,omitempty"`
+		PostalCode             *PostalCode             `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
 	}
 
 	// PostBoxNumber - Specification of the number of a postbox
 	PostBoxNumber struct {
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// PostOffice - Specification of a post office.
-	//
-	// Examples are a rural post office where post is delivered and a post office containing post office boxes.
-	PostOffice struct {
-		AttrType         string            `json:"attr_type,omitempty"`      // maxLength=14
-		AttrIndicator    string            `json:"attr_indicator,omitempty"` // eg. Kottivakkam (P.O) here (P.O) is the Indicator
-		PostOfficeName   *PostOfficeName   `json:"post_office_name,omitempty"`
-		PostOfficeNumber *PostOfficeNumber `json:"post_office_number,omitempty"`
-		PostalCode       *PostalCode       `json:"postal_code,omitempty"`
+	// PostBoxNumberExtension - Some countries like USA have POBox as 12345-123
+	PostBoxNumberExtension struct {
+		AttrNumberExtensionSeparator string `json:"attr_number_extension_separator,omitempty" xml:"NumberExtensionSeparator,attr,omitempty"` // "-" is the NumberExtensionSeparator in POBOX:12345-123
+		Text                         string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// PostOfficeName - Specification of the name of the post office.
-	//
-	// This can be a rural post office where post is delivered or a post office containing post office boxes.
+	// PostBoxNumberPrefix - Specification of the prefix of the post box number. eg. A in POBox:A-123
+	PostBoxNumberPrefix struct {
+		AttrNumberPrefixSeparator string `json:"attr_number_prefix_separator,omitempty" xml:"NumberPrefixSeparator,attr,omitempty"` // A-12 where 12 is number and A is prefix and "-" is the separator
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                     // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostBoxNumberSuffix - Specification of the suffix of the post box number. eg. A in POBox:123A
+	PostBoxNumberSuffix struct {
+		AttrNumberSuffixSeparator string `json:"attr_number_suffix_separator,omitempty" xml:"NumberSuffixSeparator,attr,omitempty"` // 12-A where 12 is number and A is suffix and "-" is the separator
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                     // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostOffice - Specification of a post office. Examples are a rural post office where post is delivered and a
+	// post office containing post office boxes.
+	PostOffice struct {
+		AttrType         string            `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`           // maxLength=14; Could be a Mobile Postoffice Van as in Isreal
+		AttrIndicator    string            `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"` // eg. Kottivakkam (P.O) here (P.O) is the Indicator
+		AddressLine      []*AddressLine    `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PostOfficeName   []*PostOfficeName `json:"post_office_name,omitempty" xml:"PostOfficeName,omitempty"`     // choice
+		PostOfficeNumber *PostOfficeNumber `json:"post_office_number,omitempty" xml:"PostOfficeNumber,omitempty"` // choice
+		PostalRoute      *PostalRoute      `json:"postal_route,omitempty" xml:"PostalRoute,omitempty"`
+		PostBox          *PostBox          `json:"post_box,omitempty" xml:"PostBox,omitempty"`
+		PostalCode       *PostalCode       `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
+	}
+
+	// PostOfficeName - Specification of the name of the post office. This can be a rural postoffice where post is
+	// delivered or a post office containing post office boxes.
 	PostOfficeName struct {
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// PostOfficeNumber - Specification of the number of the post office.
-	//
-	// Common in rural post offices
+	// PostOfficeNumber - Specification of the number of the postoffice. Common in rural postoffices
 	PostOfficeNumber struct {
-		AttrIndicator string `json:"attr_indicator,omitempty"` // maxLength=3
-		Text          string `json:"text,omitempty"`
+		AttrIndicator           string `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`                      // maxLength=3; MS in MS 62, # in MS # 12, etc.
+		AttrIndicatorOccurrence string `json:"attr_indicator_occurrence,omitempty" xml:"IndicatorOccurrence,attr,omitempty"` // enum=Before|After; MS occurs before 62 in MS 62
+		AttrCode                string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                // Used by postal services to encode the name of the element.
+		Text                    string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostTown - A post town is not the same as a locality. A post town can encompass a collection of (small)
+	// localities. It can also be a subpart of a locality. An actual post town in Norway is "Bergen".
+	PostTown struct {
+		AttrType       string          `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // eg. village, town, suburb, etc
+		AddressLine    []*AddressLine  `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PostTownName   []*PostTownName `json:"post_town_name,omitempty" xml:"PostTownName,omitempty"`
+		PostTownSuffix *PostTownSuffix `json:"post_town_suffix,omitempty" xml:"PostTownSuffix,omitempty"`
+	}
+
+	// PostTownName - Name of the post town
+	PostTownName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostTownSuffix - GENERAL PO in MIAMI GENERAL PO
+	PostTownSuffix struct {
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// PostalCode - PostalCode is the container element for either simple or complex (extended) postal codes.
-	//
 	// Type: Area Code, Postcode, etc.
 	PostalCode struct {
-		AttrType                  string                     `json:"attr_type,omitempty"` // maxLength=9
-		PostalCodeNumber          *PostalCodeNumber          `json:"postal_code_number,omitempty"`
-		PostalCodeNumberExtension *PostalCodeNumberExtension `json:"postal_code_number_extension,omitempty"`
+		AttrType                  string                       `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // maxLength=9; Area Code, Postcode, Delivery code as in NZ, etc
+		AddressLine               []*AddressLine               `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PostalCodeNumber          []*PostalCodeNumber          `json:"postal_code_number,omitempty" xml:"PostalCodeNumber,omitempty"`
+		PostalCodeNumberExtension []*PostalCodeNumberExtension `json:"postal_code_number_extension,omitempty" xml:"PostalCodeNumberExtension,omitempty"`
+		PostTown                  *PostTown                    `json:"post_town,omitempty" xml:"PostTown,omitempty"`
 	}
 
-	// PostalCodeNumber - Specification of a postcode.
-	//
-	// The postcode is formatted according to country-specific rules, example:
-	//  SW3 0A8-1A, 600074, 2067
+	// PostalCodeNumber - Specification of a postcode. The postcode is formatted according to country-specific
+	// rules. Example: SW3 0A8-1A, 600074, 2067
 	PostalCodeNumber struct {
-		AttrType string `json:"attr_type,omitempty"` // Old Postal Code, new code, etc
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Old Postal Code, new code, etc
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// PostalCodeNumberExtension - Examples are:
-	//  1234 (USA), 1G (UK), etc.
+	// PostalCodeNumberExtension - Examples are: 1234 (USA), 1G (UK), etc.
 	PostalCodeNumberExtension struct {
-		AttrType                     string `json:"attr_type,omitempty"`                       // maxLength=19
-		AttrNumberExtensionSeparator string `json:"attr_number_extension_separator,omitempty"` // The separator between postal code number and the extension. Eg. "-"
-		Text                         string `json:"text,omitempty"`
-	}
-
-	// Premise - Specification of a single premise, for example a house or a building.
-	//
-	// The premise as a whole has a unique premise (house) number or a premise name.
-	// There could be more than one premise in a street referenced in an address.
-	// For example a building address near a major shopping centre or raiwlay station
-	//
-	// AttrType: COMPLEXE in COMPLEX DES JARDINS, A building, station, etc
-	//
-	// AttrPremiseDependency: STREET, PREMISE, SUBPREMISE, PARK, FARM, etc
-	//
-	// AttrPremiseDependencyType: NEAR, ADJACENT TO, etc
-	//
-	// AttrPremiseThoroughfareConnector: DES, DE, LA, LA, DU in RUE DU BOIS. These terms connect a premise/thoroughfare type and premise/thoroughfare name. Terms may appear with names AVE DU BOIS
+		AttrType                     string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`                                           // maxLength=19; Delivery Point Suffix, New Postal Code, etc..
+		AttrNumberExtensionSeparator string `json:"attr_number_extension_separator,omitempty" xml:"NumberExtensionSeparator,attr,omitempty"` // The separator between postal code number and the extension. Eg. "-"
+		AttrCode                     string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                           // Used by postal services to encode the name of the element.
+		Text                         string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostalRoute - A Postal van is specific for a route as in Is`rael, Rural route
+	PostalRoute struct {
+		AttrType          string             `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AddressLine       []*AddressLine     `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PostalRouteName   []*PostalRouteName `json:"postal_route_name,omitempty" xml:"PostalRouteName,omitempty"`     // choice
+		PostalRouteNumber *PostalRouteNumber `json:"postal_route_number,omitempty" xml:"PostalRouteNumber,omitempty"` // choice
+		PostBox           *PostBox           `json:"post_box,omitempty" xml:"PostBox,omitempty"`
+	}
+
+	// PostalRouteName - Name of the Postal Route
+	PostalRouteName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostalRouteNumber - Number of the Postal Route
+	PostalRouteNumber struct {
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PostalServiceElements - Postal authorities use specific postal service data to expedient delivery of mail
+	PostalServiceElements struct {
+		AttrType                       string                            `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // USPS, ECMA, UN/PROLIST, etc
+		AddressIdentifier              []*AddressIdentifier              `json:"address_identifier,omitempty" xml:"AddressIdentifier,omitempty"`
+		EndorsementLineCode            *EndorsementLineCode              `json:"endorsement_line_code,omitempty" xml:"EndorsementLineCode,omitempty"`
+		KeyLineCode                    *KeyLineCode                      `json:"key_line_code,omitempty" xml:"KeyLineCode,omitempty"`
+		Barcode                        *Barcode                          `json:"barcode,omitempty" xml:"Barcode,omitempty"`
+		SortingCode                    *SortingCode                      `json:"sorting_code,omitempty" xml:"SortingCode,omitempty"`
+		AddressLatitude                *AddressLatitude                  `json:"address_latitude,omitempty" xml:"AddressLatitude,omitempty"`
+		AddressLatitudeDirection       *AddressLatitudeDirection         `json:"address_latitude_direction,omitempty" xml:"AddressLatitudeDirection,omitempty"`
+		AddressLongitude               *AddressLongitude                 `json:"address_longitude,omitempty" xml:"AddressLongitude,omitempty"`
+		AddressLongitudeDirection      *AddressLongitudeDirection        `json:"address_longitude_direction,omitempty" xml:"AddressLongitudeDirection,omitempty"`
+		SupplementaryPostalServiceData []*SupplementaryPostalServiceData `json:"supplementary_postal_service_data,omitempty" xml:"SupplementaryPostalServiceData,omitempty"`
+	}
+
+	// Premise - Specification of a single premise, for example a house or a building. The premise as a whole has
+	// a unique premise (house) number or a premise name. There could be more than one premise in a street
+	// referenced in an address. For example a building address near a major shopping centre or raiwlay station
 	Premise struct {
-		AttrPremiseDependency            string               `json:"attr_premise_dependency,omitempty"`      // maxLength=7
-		AttrPremiseDependencyType        string               `json:"attr_premise_dependency_type,omitempty"` // maxLength=19
-		AttrType                         string               `json:"attr_type,omitempty"`                    // maxLength=18
-		AttrPremiseThoroughfareConnector string               `json:"attr_premise_thoroughfare_connector,omitempty"`
-		BuildingName                     *BuildingName        `json:"building_name,omitempty"`
-		PostalCode                       *PostalCode          `json:"postal_code,omitempty"`
-		Premise                          *Premise             `json:"premise,omitempty"`
-		PremiseLocation                  *PremiseLocation     `json:"premise_location,omitempty"`
-		PremiseName                      *PremiseName         `json:"premise_name,omitempty"`
-		PremiseNumber                    *PremiseNumber       `json:"premise_number,omitempty"`
-		PremiseNumberSuffix              *PremiseNumberSuffix `json:"premise_number_suffix,omitempty"`
-		SubPremise                       []*SubPremise        `json:"sub_premise,omitempty"`
+		AttrType                         string                 `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`                                                   // maxLength=18; COMPLEXE in COMPLEX DES JARDINS, A building, station, etc
+		AttrPremiseDependency            string                 `json:"attr_premise_dependency,omitempty" xml:"PremiseDependency,attr,omitempty"`                        // maxLength=7; STREET, PREMISE, SUBPREMISE, PARK, FARM, etc
+		AttrPremiseDependencyType        string                 `json:"attr_premise_dependency_type,omitempty" xml:"PremiseDependencyType,attr,omitempty"`               // maxLength=19; NEAR, ADJACENT TO, etc
+		AttrPremiseThoroughfareConnector string                 `json:"attr_premise_thoroughfare_connector,omitempty" xml:"PremiseThoroughfareConnector,attr,omitempty"` // DES, DE, LA, LA, DU in RUE DU BOIS. These terms connect a premise/thoroughfare type and premise/thoroughfare name. Terms may appear with names AVE DU BOIS
+		AddressLine                      []*AddressLine         `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PremiseName                      []*PremiseName         `json:"premise_name,omitempty" xml:"PremiseName,omitempty"`
+		PremiseLocation                  *PremiseLocation       `json:"premise_location,omitempty" xml:"PremiseLocation,omitempty"`        // choice
+		PremiseNumber                    []*PremiseNumber       `json:"premise_number,omitempty" xml:"PremiseNumber,omitempty"`            // choice
+		PremiseNumberRange               *PremiseNumberRange    `json:"premise_number_range,omitempty" xml:"PremiseNumberRange,omitempty"` // choice
+		PremiseNumberPrefix              []*PremiseNumberPrefix `json:"premise_number_prefix,omitempty" xml:"PremiseNumberPrefix,omitempty"`
+		PremiseNumberSuffix              []*PremiseNumberSuffix `json:"premise_number_suffix,omitempty" xml:"PremiseNumberSuffix,omitempty"`
+		BuildingName                     []*BuildingName        `json:"building_name,omitempty" xml:"BuildingName,omitempty"`
+		SubPremise                       []*SubPremise          `json:"sub_premise,omitempty" xml:"SubPremise,omitempty"` // choice
+		Firm                             *Firm                  `json:"firm,omitempty" xml:"Firm,omitempty"`              // choice
+		MailStop                         *MailStop              `json:"mail_stop,omitempty" xml:"MailStop,omitempty"`
+		PostalCode                       *PostalCode            `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
+		Premise                          *Premise               `json:"premise,omitempty" xml:"Premise,omitempty"`
 	}
 
 	// PremiseLocation - LOBBY, BASEMENT, GROUND FLOOR, etc...
 	PremiseLocation struct {
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// PremiseName - Specification of the name of the premise (house, building, park, farm, etc).
-	//
-	// A premise name is specified when the premise cannot be addressed using a street name plus premise (house) number.
-	//
-	// AttrTypeOccurrence: EGIS Building where EGIS occurs before Building, DES JARDINS occurs after COMPLEXE DES JARDINS
+	// PremiseName - Specification of the name of the premise (house, building, park, farm, etc). A premise name
+	// is specified when the premise cannot be addressed using a street name plus premise (house) number.
 	PremiseName struct {
-		AttrTypeOccurrence string `json:"attr_type_occurrence,omitempty"` // maxLength=5
-		Text               string `json:"text,omitempty"`
+		AttrType           string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrTypeOccurrence string `json:"attr_type_occurrence,omitempty" xml:"TypeOccurrence,attr,omitempty"` // enum=Before|After; EGIS Building where EGIS occurs before Building, DES JARDINS occurs after COMPLEXE DES JARDINS
+		AttrCode           string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                      // Used by postal services to encode the name of the element.
+		Text               string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// PremiseNumber - Specification of the identifier of the premise (house, building, etc).
-	//
-	// Premises in a street are often uniquely identified by means of consecutive identifiers.
-	// The identifier can be a number, a letter or any combination of the two.
+	// PremiseNumber - Specification of the identifier of the premise (house, building, etc). Premises in a street
+	// are often uniquely identified by means of consecutive identifiers. The identifier can be a number, a letter
+	// or any combination of the two.
 	PremiseNumber struct {
-		AttrNumberType           string `json:"attr_number_type,omitempty"`            // Building 12-14 is "Range" and Building 12 is "Single"
-		AttrType                 string `json:"attr_type,omitempty"`                   //
-		AttrIndicator            string `json:"attr_indicator,omitempty"`              // No. in House No.12, # in #12, etc.
-		AttrIndicatorOccurrence  string `json:"attr_indicator_occurrence,omitempty"`   // No. occurs before 12 No.12
-		AttrNumberTypeOccurrence string `json:"attr_number_type_occurrence,omitempty"` // 12 in BUILDING 12 occurs "after" premise type BUILDING
-		Code                     string `json:"code,omitempty"`                        // Used by postal services to encode the name of the element.
-		Text                     string `json:"text,omitempty"`
+		AttrNumberType           string `json:"attr_number_type,omitempty" xml:"NumberType,attr,omitempty"` // enum=Single|Range; Building 12-14 is "Range" and Building 12 is "Single"
+		AttrType                 string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrIndicator            string `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`                         // No. in House No.12, # in #12, etc.
+		AttrIndicatorOccurrence  string `json:"attr_indicator_occurrence,omitempty" xml:"IndicatorOccurrence,attr,omitempty"`    // enum=Before|After; No. occurs before 12 No.12
+		AttrNumberTypeOccurrence string `json:"attr_number_type_occurrence,omitempty" xml:"NumberTypeOccurrence,attr,omitempty"` // enum=Before|After; 12 in BUILDING 12 occurs "after" premise type BUILDING
+		AttrCode                 string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                   // Used by postal services to encode the name of the element.
+		Text                     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PremiseNumberPrefix - A in A12
+	PremiseNumberPrefix struct {
+		AttrNumberPrefixSeparator string `json:"attr_number_prefix_separator,omitempty" xml:"NumberPrefixSeparator,attr,omitempty"` // A-12 where 12 is number and A is prefix and "-" is the separator
+		AttrType                  string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PremiseNumberRange - Specification for defining the premise number range. Some premises have number as
+	// Building C1-C7
+	PremiseNumberRange struct {
+		AttrRangeType            string                  `json:"attr_range_type,omitempty" xml:"RangeType,attr,omitempty"` // Eg. Odd or even number range
+		AttrIndicator            string                  `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`  // Eg. No. in Building No:C1-C5
+		AttrSeparator            string                  `json:"attr_separator,omitempty" xml:"Separator,attr,omitempty"`  // "-" in 12-14 or "Thru" in 12 Thru 14 etc.
+		AttrType                 string                  `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrIndicatorOccurence   string                  `json:"attr_indicator_occurence,omitempty" xml:"IndicatorOccurence,attr,omitempty"`      // enum=Before|After; No.12-14 where "No." is before actual street number
+		AttrNumberRangeOccurence string                  `json:"attr_number_range_occurence,omitempty" xml:"NumberRangeOccurence,attr,omitempty"` // enum=BeforeName|AfterName|BeforeType|AfterType; Building 23-25 where the number occurs after building name
+		PremiseNumberRangeFrom   *PremiseNumberRangeFrom `json:"premise_number_range_from,omitempty" xml:"PremiseNumberRangeFrom,omitempty"`
+		PremiseNumberRangeTo     *PremiseNumberRangeTo   `json:"premise_number_range_to,omitempty" xml:"PremiseNumberRangeTo,omitempty"`
+	}
+
+	// PremiseNumberRangeFrom - Start number details of the premise number range
+	PremiseNumberRangeFrom struct {
+		AddressLine         []*AddressLine         `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PremiseNumberPrefix []*PremiseNumberPrefix `json:"premise_number_prefix,omitempty" xml:"PremiseNumberPrefix,omitempty"`
+		PremiseNumber       []*PremiseNumber       `json:"premise_number,omitempty" xml:"PremiseNumber,omitempty"`
+		PremiseNumberSuffix []*PremiseNumberSuffix `json:"premise_number_suffix,omitempty" xml:"PremiseNumberSuffix,omitempty"`
+		Text                string                 `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// PremiseNumberRangeTo - End number details of the premise number range
+	PremiseNumberRangeTo struct {
+		AddressLine         []*AddressLine         `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		PremiseNumberPrefix []*PremiseNumberPrefix `json:"premise_number_prefix,omitempty" xml:"PremiseNumberPrefix,omitempty"`
+		PremiseNumber       []*PremiseNumber       `json:"premise_number,omitempty" xml:"PremiseNumber,omitempty"`
+		PremiseNumberSuffix []*PremiseNumberSuffix `json:"premise_number_suffix,omitempty" xml:"PremiseNumberSuffix,omitempty"`
+		Text                string                 `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// PremiseNumberSuffix - A in 12A
 	PremiseNumberSuffix struct {
-		AttrNumberPrefixSeparator string `json:"attr_number_prefix_separator,omitempty"` // A-12 where 12 is number and A is prefix and "-" is the separator
-		AttrType                  string `json:"attr_type,omitempty"`                    //
-		AttrCode                  string `json:"attr_code,omitempty"`                    // Used by postal services to encode the name of the element.
-		Text                      string `json:"text,omitempty"`
-	}
-
-	// SubPremise - Specification of a single sub-premise.
-	//
-	// Examples of sub-premises are apartments and suites. Each sub-premise should be uniquely identifiable.
+		AttrNumberSuffixSeparator string `json:"attr_number_suffix_separator,omitempty" xml:"NumberSuffixSeparator,attr,omitempty"` // 12-A where 12 is number and A is suffix and "-" is the separator
+		AttrType                  string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// SortingCode - Used for sorting addresses. Values may for example be CEDEX 16 (France)
+	SortingCode struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// SubAdministrativeArea - Specification of a sub-administrative area. An example of a sub-administrative
+	// areas is a county. There are two places where the name of an administrative area can be specified and in
+	// this case, one becomes sub-administrative area.
+	SubAdministrativeArea struct {
+		AttrType                  string                       `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`            // Province or State or County or Kanton, etc
+		AttrUsageType             string                       `json:"attr_usage_type,omitempty" xml:"UsageType,attr,omitempty"` // Postal or Political - Sometimes locations must be distinguished between postal system, and physical locations as defined by a political system
+		AttrIndicator             string                       `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`  // Erode (Dist) where (Dist) is the Indicator
+		AddressLine               []*AddressLine               `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		SubAdministrativeAreaName []*SubAdministrativeAreaName `json:"sub_administrative_area_name,omitempty" xml:"SubAdministrativeAreaName,omitempty"`
+		Locality                  *Locality                    `json:"locality,omitempty" xml:"Locality,omitempty"`      // choice
+		PostOffice                *PostOffice                  `json:"post_office,omitempty" xml:"PostOffice,omitempty"` // choice
+		PostalCode                *PostalCode                  `json:"postal_code,omitempty" xml:"PostalCode,omitempty"` // choice
+	}
+
+	// SubAdministrativeAreaName - Name of the sub-administrative area
+	SubAdministrativeAreaName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// SubPremise - Specification of a single sub-premise. Examples of sub-premises are apartments and suites.
+	// Each sub-premise should be uniquely identifiable.
 	SubPremise struct {
-		AttrType               string                  `json:"attr_type,omitempty"` // maxLength=9
-		SubPremise             []*SubPremise           `json:"sub_premise,omitempty"`
-		SubPremiseName         []*SubPremiseName       `json:"sub_premise_name,omitempty"`
-		SubPremiseNumber       []*SubPremiseNumber     `json:"sub_premise_number,omitempty"`
-		SubPremiseNumberSuffix *SubPremiseNumberSuffix `json:"sub_premise_number_suffix,omitempty"`
+		AttrType               string                    `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // maxLength=9
+		AddressLine            []*AddressLine            `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		SubPremiseName         []*SubPremiseName         `json:"sub_premise_name,omitempty" xml:"SubPremiseName,omitempty"`
+		SubPremiseLocation     *SubPremiseLocation       `json:"sub_premise_location,omitempty" xml:"SubPremiseLocation,omitempty"` // choice
+		SubPremiseNumber       []*SubPremiseNumber       `json:"sub_premise_number,omitempty" xml:"SubPremiseNumber,omitempty"`     // choice
+		SubPremiseNumberPrefix []*SubPremiseNumberPrefix `json:"sub_premise_number_prefix,omitempty" xml:"SubPremiseNumberPrefix,omitempty"`
+		SubPremiseNumberSuffix []*SubPremiseNumberSuffix `json:"sub_premise_number_suffix,omitempty" xml:"SubPremiseNumberSuffix,omitempty"`
+		BuildingName           []*BuildingName           `json:"building_name,omitempty" xml:"BuildingName,omitempty"`
+		Firm                   *Firm                     `json:"firm,omitempty" xml:"Firm,omitempty"`
+		MailStop               *MailStop                 `json:"mail_stop,omitempty" xml:"MailStop,omitempty"`
+		PostalCode             *PostalCode               `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`
+		SubPremise             *SubPremise               `json:"sub_premise,omitempty" xml:"SubPremise,omitempty"`
+	}
+
+	// SubPremiseLocation - Name of the SubPremise Location. eg. LOBBY, BASEMENT, GROUND FLOOR, etc...
+	SubPremiseLocation struct {
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// SubPremiseName - Name of the SubPremise
+	SubPremiseName struct {
+		AttrType           string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrTypeOccurrence string `json:"attr_type_occurrence,omitempty" xml:"TypeOccurrence,attr,omitempty"` // enum=Before|After; EGIS Building where EGIS occurs before Building
+		AttrCode           string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                      // Used by postal services to encode the name of the element.
+		Text               string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// SubPremiseName -  Name of the SubPremise
-	SubPremiseName struct {
-		AttrType           string `json:"attr_type,omitempty"`
-		AttrTypeOccurrence string `json:"attr_type_occurrence,omitempty"` //  EGIS Building where EGIS occurs before Building
-		AttrCode           string `json:"attr_code,omitempty"`            //  Used by postal services to encode the name of the element.
-		Text               string `json:"text,omitempty"`
-	}
-
-	// SubPremiseNumber -  Specification of the identifier of a sub-premise.
-	//
-	// Examples of sub-premises are apartments and suites. sub-premises in a building are often uniquely identified
-	// by means of consecutive identifiers. The identifier can be a number, a letter or any combination of the two.
-	// In the latter case, the identifier includes exactly one variable (range) part, which is either a number,
-	// or a single letter that is surrounded by fixed parts at the left (prefix) or the right (postfix).
+	// SubPremiseNumber - Specification of the identifier of a sub-premise. Examples of sub-premises are
+	// apartments and suites. sub-premises in a building are often uniquely identified by means of consecutive
+	// identifiers. The identifier can be a number, a letter or any combination of the two. In the latter case,
+	// the identifier includes exactly one variable (range) part, which is either a number or a single letter that
+	// is surrounded by fixed parts at the left (prefix) or the right (postfix).
 	SubPremiseNumber struct {
-		AttrIndicator              string `json:"attr_indicator,omitempty"`                // "TH" in 12TH which is a floor number, "NO." in NO.1, "#" in APT #12, etc.
-		AttrIndicatorOccurrence    string `json:"attr_indicator_occurrence,omitempty"`     // "No." occurs before 1 in No.1, or TH occurs after 12 in 12TH
-		AttrNumberTypeOccurrence   string `json:"attr_number_type_occurrence,omitempty"`   // 12TH occurs "before" FLOOR (a type of subpremise) in 12TH FLOOR
-		AttrPremiseNumberSeparator string `json:"attr_premise_number_separator,omitempty"` // "/" in 12/14 Archer Street where 12 is sub-premise number and 14 is premise number
-		AttrType                   string `json:"attr_type,omitempty"`                     //
-		AttrCode                   string `json:"attr_code,omitempty"`                     // Used by postal services to encode the name of the element.
-		Text                       string `json:"text,omitempty"`
+		AttrIndicator              string `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`                             // "TH" in 12TH which is a floor number, "NO." in NO.1, "#" in APT #12, etc.
+		AttrIndicatorOccurrence    string `json:"attr_indicator_occurrence,omitempty" xml:"IndicatorOccurrence,attr,omitempty"`        // enum=Before|After; "No." occurs before 1 in No.1, or TH occurs after 12 in 12TH
+		AttrNumberTypeOccurrence   string `json:"attr_number_type_occurrence,omitempty" xml:"NumberTypeOccurrence,attr,omitempty"`     // enum=Before|After; 12TH occurs "before" FLOOR (a type of subpremise) in 12TH FLOOR
+		AttrPremiseNumberSeparator string `json:"attr_premise_number_separator,omitempty" xml:"PremiseNumberSeparator,attr,omitempty"` // "/" in 12/14 Archer Street where 12 is sub-premise number and 14 is premise number
+		AttrType                   string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                   string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                       string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// SubPremiseNumberSuffix -  Prefix of the sub premise number. eg. A in A-12
+	// SubPremiseNumberPrefix - Prefix of the sub premise number. eg. A in A-12
+	SubPremiseNumberPrefix struct {
+		AttrNumberPrefixSeparator string `json:"attr_number_prefix_separator,omitempty" xml:"NumberPrefixSeparator,attr,omitempty"` // A-12 where 12 is number and A is prefix and "-" is the separator
+		AttrType                  string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// SubPremiseNumberSuffix - Suffix of the sub premise number. eg. A in 12A
 	SubPremiseNumberSuffix struct {
-		AttrNumberSuffixSeparator string `json:"attr_number_suffix_separator,omitempty"` //  12-A where 12 is number and A is suffix and "-" is the separator
-		AttrType                  string `json:"attr_type,omitempty"`                    //
-		AttrCode                  string `json:"attr_code,omitempty"`                    //  Used by postal services to encode the name of the element.
-		Text                      string `json:"text,omitempty"`
-	}
-
-	// Thoroughfare - Specification of a thoroughfare.
-	//
-	// A thoroughfare could be a rd, street, canal, river, etc. Note dependentlocality in a street.
-	// For example, in some countries, a large street will have many subdivisions with numbers.
-	// Normally the subdivision name is the same as the road name, but with a number to identifiy it. Eg.
-	//  SOI SUKUMVIT 3, SUKUMVIT RD, BANGKOK
+		AttrNumberSuffixSeparator string `json:"attr_number_suffix_separator,omitempty" xml:"NumberSuffixSeparator,attr,omitempty"` // 12-A where 12 is number and A is suffix and "-" is the separator
+		AttrType                  string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// SupplementaryPostalServiceData - any postal service elements not covered by the container can be
+	// represented using this element
+	SupplementaryPostalServiceData struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"` // Specific to postal service
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// Thoroughfare - Specification of a thoroughfare. A thoroughfare could be a rd, street, canal, river, etc.
+	// Note dependentlocality in a street. For example, in some countries, a large street will have many
+	// subdivisions with numbers. Normally the subdivision name is the same as the road name, but with a number to
+	// identifiy it. Eg. SOI SUKUMVIT 3, SUKUMVIT RD, BANGKOK
 	Thoroughfare struct {
-		AttrDependentThoroughfares          string                     `json:"attr_dependent_thoroughfares,omitempty"`           // maxLength=3
-		AttrDependentThoroughfaresConnector string                     `json:"attr_dependent_thoroughfares_connector,omitempty"` // maxLength=3
-		AttrDependentThoroughfaresIndicator string                     `json:"attr_dependent_thoroughfares_indicator,omitempty"` // maxLength=9
-		AttrDependentThoroughfaresType      string                     `json:"attr_dependent_thoroughfares_type,omitempty"`      // STS in GEORGE and ADELAIDE STS, RDS IN A and B RDS, etc. Use only when both the street types are the same
-		AttrType                            string                     `json:"attr_type,omitempty"`                              // maxLength=6
-		DependentLocality                   *DependentLocality         `json:"dependent_locality,omitempty"`
-		DependentThoroughfare               *DependentThoroughfare     `json:"dependent_thoroughfare,omitempty"`
-		PostalCode                          *PostalCode                `json:"postal_code,omitempty"`
-		Premise                             *Premise                   `json:"premise,omitempty"`
-		ThoroughfareLeadingType             *ThoroughfareLeadingType   `json:"thoroughfare_leading_type,omitempty"`
-		ThoroughfareName                    *ThoroughfareName          `json:"thoroughfare_name,omitempty"`
-		ThoroughfareNumber                  *ThoroughfareNumber        `json:"thoroughfare_number,omitempty"`
-		ThoroughfareNumberRange             *ThoroughfareNumberRange   `json:"thoroughfare_number_range,omitempty"`
-		ThoroughfareNumberSuffix            *ThoroughfareNumberSuffix  `json:"thoroughfare_number_suffix,omitempty"`
-		ThoroughfarePostDirection           *ThoroughfarePostDirection `json:"thoroughfare_post_direction,omitempty"`
-		ThoroughfarePreDirection            *ThoroughfarePreDirection  `json:"thoroughfare_pre_direction,omitempty"`
-		ThoroughfareTrailingType            *ThoroughfareTrailingType  `json:"thoroughfare_trailing_type,omitempty"`
-	}
-
-	// ThoroughfareLeadingType - Appears before the thoroughfare name.
-	//
-	//  Spanish: Avenida Aurora, where Avenida is the leading type
-	//  French: Rue Moliere, where Rue is the leading type.
-	ThoroughfareLeadingType string
-
-	// ThoroughfareName - Specification of the name of a Thoroughfare
-	//
-	// Also dependant street name: street name, canal name, etc.
-	ThoroughfareName string
+		AttrType                            string                      `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`                                                         // maxLength=6
+		AttrDependentThoroughfares          string                      `json:"attr_dependent_thoroughfares,omitempty" xml:"DependentThoroughfares,attr,omitempty"`                    // maxLength=3; enum=Yes|No; Does this thoroughfare have a a dependent thoroughfare? Corner of street X, etc
+		AttrDependentThoroughfaresIndicator string                      `json:"attr_dependent_thoroughfares_indicator,omitempty" xml:"DependentThoroughfaresIndicator,attr,omitempty"` // maxLength=9; Corner of, Intersection of
+		AttrDependentThoroughfaresConnector string                      `json:"attr_dependent_thoroughfares_connector,omitempty" xml:"DependentThoroughfaresConnector,attr,omitempty"` // maxLength=3; Corner of Street1 AND Street 2 where AND is the Connector
+		AttrDependentThoroughfaresType      string                      `json:"attr_dependent_thoroughfares_type,omitempty" xml:"DependentThoroughfaresType,attr,omitempty"`           // STS in GEORGE and ADELAIDE STS, RDS IN A and B RDS, etc. Use only when both the street types are the same
+		AddressLine                         []*AddressLine              `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		ThoroughfareNumber                  []*ThoroughfareNumber       `json:"thoroughfare_number,omitempty" xml:"ThoroughfareNumber,omitempty"`
+		ThoroughfareNumberRange             []*ThoroughfareNumberRange  `json:"thoroughfare_number_range,omitempty" xml:"ThoroughfareNumberRange,omitempty"`
+		ThoroughfareNumberPrefix            []*ThoroughfareNumberPrefix `json:"thoroughfare_number_prefix,omitempty" xml:"ThoroughfareNumberPrefix,omitempty"`
+		ThoroughfareNumberSuffix            []*ThoroughfareNumberSuffix `json:"thoroughfare_number_suffix,omitempty" xml:"ThoroughfareNumberSuffix,omitempty"`
+		ThoroughfarePreDirection            *ThoroughfarePreDirection   `json:"thoroughfare_pre_direction,omitempty" xml:"ThoroughfarePreDirection,omitempty"`
+		ThoroughfareLeadingType             *ThoroughfareLeadingType    `json:"thoroughfare_leading_type,omitempty" xml:"ThoroughfareLeadingType,omitempty"`
+		ThoroughfareName                    []*ThoroughfareName         `json:"thoroughfare_name,omitempty" xml:"ThoroughfareName,omitempty"`
+		ThoroughfareTrailingType            *ThoroughfareTrailingType   `json:"thoroughfare_trailing_type,omitempty" xml:"ThoroughfareTrailingType,omitempty"`
+		ThoroughfarePostDirection           *ThoroughfarePostDirection  `json:"thoroughfare_post_direction,omitempty" xml:"ThoroughfarePostDirection,omitempty"`
+		DependentThoroughfare               *DependentThoroughfare      `json:"dependent_thoroughfare,omitempty" xml:"DependentThoroughfare,omitempty"`
+		DependentLocality                   *DependentLocality          `json:"dependent_locality,omitempty" xml:"DependentLocality,omitempty"` // choice
+		Premise                             *Premise                    `json:"premise,omitempty" xml:"Premise,omitempty"`                      // choice
+		Firm                                *Firm                       `json:"firm,omitempty" xml:"Firm,omitempty"`                            // choice
+		PostalCode                          *PostalCode                 `json:"postal_code,omitempty" xml:"PostalCode,omitempty"`               // choice
+	}
+
+	// ThoroughfareLeadingType - Appears before the thoroughfare name. Ed. Spanish: Avenida Aurora, where Avenida
+	// is the leading type / French: Rue Moliere, where Rue is the leading type.
+	ThoroughfareLeadingType struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// ThoroughfareName - Specification of the name of a Thoroughfare (also dependant street name): street name,
+	// canal name, etc.
+	ThoroughfareName struct {
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
+	}
 
 	// ThoroughfareNumber - Eg.: 23 Archer street or 25/15 Zero Avenue, etc
 	ThoroughfareNumber struct {
-		AttrType                string `json:"attr_type,omitempty"`
-		AttrNumberType          string `json:"attr_number_type,omitempty"`          // 12 Archer Street is "Single" and 12-14 Archer Street is "Range"
-		AttrIndicator           string `json:"attr_indicator,omitempty"`            // maxLength=3; No. in Street No.12 or "#" in Street # 12, etc.
-		AttrIndicatorOccurrence string `json:"attr_indicator_occurrence,omitempty"` // maxLength=6; No.12 where "No." is before actual street number
-		AttrNumberOccurrence    string `json:"attr_number_occurrence,omitempty"`    // 23 Archer St, Archer Street 23, St Archer 23
-		Text                    string `json:"text,omitempty"`
+		AttrNumberType          string `json:"attr_number_type,omitempty" xml:"NumberType,attr,omitempty"` // enum=Single|Range; 12 Archer Street is "Single" and 12-14 Archer Street is "Range"
+		AttrType                string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrIndicator           string `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`                      // maxLength=3; No. in Street No.12 or "#" in Street # 12, etc.
+		AttrIndicatorOccurrence string `json:"attr_indicator_occurrence,omitempty" xml:"IndicatorOccurrence,attr,omitempty"` // maxLength=6; enum=Before|After; No.12 where "No." is before actual street number
+		AttrNumberOccurrence    string `json:"attr_number_occurrence,omitempty" xml:"NumberOccurrence,attr,omitempty"`       // enum=BeforeName|AfterName|BeforeType|AfterType; 23 Archer St, Archer Street 23, St Archer 23
+		AttrCode                string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                // Used by postal services to encode the name of the element.
+		Text                    string `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// ThoroughfareNumberFrom - Starting number in the range
 	ThoroughfareNumberFrom struct {
-		AttrCode           string              `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		ThoroughfareNumber *ThoroughfareNumber `json:"thoroughfare_number,omitempty"`
+		AttrCode                 string                      `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		AddressLine              []*AddressLine              `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		ThoroughfareNumberPrefix []*ThoroughfareNumberPrefix `json:"thoroughfare_number_prefix,omitempty" xml:"ThoroughfareNumberPrefix,omitempty"`
+		ThoroughfareNumber       []*ThoroughfareNumber       `json:"thoroughfare_number,omitempty" xml:"ThoroughfareNumber,omitempty"`
+		ThoroughfareNumberSuffix []*ThoroughfareNumberSuffix `json:"thoroughfare_number_suffix,omitempty" xml:"ThoroughfareNumberSuffix,omitempty"`
+		Text                     string                      `json:"text,omitempty" xml:",chardata"`
+	}
+
+	// ThoroughfareNumberPrefix - Prefix before the number. A in A12 Archer Street
+	ThoroughfareNumberPrefix struct {
+		AttrNumberPrefixSeparator string `json:"attr_number_prefix_separator,omitempty" xml:"NumberPrefixSeparator,attr,omitempty"`
+		AttrType                  string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// ThoroughfareNumberRange - A container to represent a range of numbers (from x thru y) for a thoroughfare.
-	//
-	//  eg. 1-2 Albert Av
+	// ThoroughfareNumberRange - A container to represent a range of numbers (from x thru y)for a thoroughfare.
+	// eg. 1-2 Albert Av
 	ThoroughfareNumberRange struct {
-		AttrIndicator          string                  `json:"attr_indicator,omitempty"` // maxLength=2
-		AttrType               string                  `json:"attr_type,omitempty"`      // maxLength=4
-		ThoroughfareNumberFrom *ThoroughfareNumberFrom `json:"thoroughfare_number_from,omitempty"`
-		ThoroughfareNumberTo   *ThoroughfareNumberTo   `json:"thoroughfare_number_to,omitempty"`
+		AttrRangeType             string                  `json:"attr_range_type,omitempty" xml:"RangeType,attr,omitempty"`                          // enum=Odd|Even; Thoroughfare number ranges are odd or even
+		AttrIndicator             string                  `json:"attr_indicator,omitempty" xml:"Indicator,attr,omitempty"`                           // maxLength=2; "No." No.12-13
+		AttrSeparator             string                  `json:"attr_separator,omitempty" xml:"Separator,attr,omitempty"`                           // "-" in 12-14 or "Thru" in 12 Thru 14 etc.
+		AttrIndicatorOccurrence   string                  `json:"attr_indicator_occurrence,omitempty" xml:"IndicatorOccurrence,attr,omitempty"`      // enum=Before|After; No.12-14 where "No." is before actual street number
+		AttrNumberRangeOccurrence string                  `json:"attr_number_range_occurrence,omitempty" xml:"NumberRangeOccurrence,attr,omitempty"` // enum=BeforeName|AfterName|BeforeType|AfterType; 23-25 Archer St, where number appears before name
+		AttrType                  string                  `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`                                     // maxLength=4
+		AttrCode                  string                  `json:"attr_code,omitempty" xml:"Code,attr,omitempty"`                                     // Used by postal services to encode the name of the element.
+		AddressLine               []*AddressLine          `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		ThoroughfareNumberFrom    *ThoroughfareNumberFrom `json:"thoroughfare_number_from,omitempty" xml:"ThoroughfareNumberFrom,omitempty"`
+		ThoroughfareNumberTo      *ThoroughfareNumberTo   `json:"thoroughfare_number_to,omitempty" xml:"ThoroughfareNumberTo,omitempty"`
 	}
 
 	// ThoroughfareNumberSuffix - Suffix after the number. A in 12A Archer Street
 	ThoroughfareNumberSuffix struct {
-		AttrNumberSuffixSeparator string `json:"attr_number_suffix_separator,omitempty"` // 12-A where 12 is number and A is suffix and "-" is the separator
-		AttrType                  string `json:"attr_type,omitempty"`                    // NEAR, ADJACENT TO, etc
-		AttrCode                  string `json:"attr_code,omitempty"`                    // Used by postal services to encode the name of the element.
-		Text                      string `json:"text,omitempty"`
+		AttrNumberSuffixSeparator string `json:"attr_number_suffix_separator,omitempty" xml:"NumberSuffixSeparator,attr,omitempty"` // NEAR, ADJACENT TO, etc 12-A where 12 is number and A is suffix and "-" is the separator
+		AttrType                  string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode                  string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text                      string `json:"text,omitempty" xml:",chardata"`
 	}
 
 	// ThoroughfareNumberTo - Ending number in the range
 	ThoroughfareNumberTo struct {
-		AttrCode           string              `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		ThoroughfareNumber *ThoroughfareNumber `json:"thoroughfare_number,omitempty"`
+		AttrCode                 string                      `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		AddressLine              []*AddressLine              `json:"address_line,omitempty" xml:"AddressLine,omitempty"`
+		ThoroughfareNumberPrefix []*ThoroughfareNumberPrefix `json:"thoroughfare_number_prefix,omitempty" xml:"ThoroughfareNumberPrefix,omitempty"`
+		ThoroughfareNumber       []*ThoroughfareNumber       `json:"thoroughfare_number,omitempty" xml:"ThoroughfareNumber,omitempty"`
+		ThoroughfareNumberSuffix []*ThoroughfareNumberSuffix `json:"thoroughfare_number_suffix,omitempty" xml:"ThoroughfareNumberSuffix,omitempty"`
+		Text                     string                      `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// ThoroughfarePostDirection - 221-bis Baker Street North, where North is the post-direction.
-	//
-	// The post-direction appears after the name.
+	// ThoroughfarePostDirection - 221-bis Baker Street North, where North is the post-direction. The
+	// post-direction appears after the name.
 	ThoroughfarePostDirection struct {
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// ThoroughfarePreDirection - North Baker Street, where North is the pre-direction.
-	//
-	// The direction appears before the name.
+	// ThoroughfarePreDirection - North Baker Street, where North is the pre-direction. The direction appears
+	// before the name.
 	ThoroughfarePreDirection struct {
-		AttrCode string `json:"attr_code,omitempty"`
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 
-	// ThoroughfareTrailingType - Appears after the thoroughfare name. Ed. British: Baker Lane, where Lane is the trailing type.
+	// ThoroughfareTrailingType - Appears after the thoroughfare name. Ed. British: Baker Lane, where Lane is the
+	// trailing type.
 	ThoroughfareTrailingType struct {
-		AttrCode string `json:"attr_code,omitempty"` // Used by postal services to encode the name of the element.
-		Text     string `json:"text,omitempty"`
+		AttrType string `json:"attr_type,omitempty" xml:"Type,attr,omitempty"`
+		AttrCode string `json:"attr_code,omitempty" xml:"Code,attr,omitempty"` // Used by postal services to encode the name of the element.
+		Text     string `json:"text,omitempty" xml:",chardata"`
 	}
 )
+
+// Values of the DependentThoroughfares attributes.
+const (
+	DependentThoroughfaresYes = "Yes"
+	DependentThoroughfaresNo  = "No"
+)
+
+// Values of the IndicatorOccurence attributes.
+const (
+	IndicatorOccurenceBefore = "Before"
+	IndicatorOccurenceAfter  = "After"
+)
+
+// Values of the IndicatorOccurrence attributes.
+const (
+	IndicatorOccurrenceBefore = "Before"
+	IndicatorOccurrenceAfter  = "After"
+)
+
+// Values of the NameNumberOccurrence attributes.
+const (
+	NameNumberOccurrenceBefore = "Before"
+	NameNumberOccurrenceAfter  = "After"
+)
+
+// Values of the NumberOccurrence attributes.
+const (
+	NumberOccurrenceBeforeName = "BeforeName"
+	NumberOccurrenceAfterName  = "AfterName"
+	NumberOccurrenceBeforeType = "BeforeType"
+	NumberOccurrenceAfterType  = "AfterType"
+)
+
+// Values of the NumberRangeOccurence attributes.
+const (
+	NumberRangeOccurenceBeforeName = "BeforeName"
+	NumberRangeOccurenceAfterName  = "AfterName"
+	NumberRangeOccurenceBeforeType = "BeforeType"
+	NumberRangeOccurenceAfterType  = "AfterType"
+)
+
+// Values of the NumberRangeOccurrence attributes.
+const (
+	NumberRangeOccurrenceBeforeName = "BeforeName"
+	NumberRangeOccurrenceAfterName  = "AfterName"
+	NumberRangeOccurrenceBeforeType = "BeforeType"
+	NumberRangeOccurrenceAfterType  = "AfterType"
+)
+
+// Values of the NumberType attributes.
+const (
+	NumberTypeSingle = "Single"
+	NumberTypeRange  = "Range"
+)
+
+// Values of the NumberTypeOccurrence attributes.
+const (
+	NumberTypeOccurrenceBefore = "Before"
+	NumberTypeOccurrenceAfter  = "After"
+)
+
+// Values of the RangeType attributes.
+const (
+	RangeTypeOdd  = "Odd"
+	RangeTypeEven = "Even"
+)
+
+// Values of the TypeOccurrence attributes.
+const (
+	TypeOccurrenceBefore = "Before"
+	TypeOccurrenceAfter  = "After"
+)
@@ -0,0 +1,602 @@
+package xal3
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	xal "github.com/ladydascalie/xal-spec"
+)
+
+// numberRE matches the numbers of a coordinate, eg. 37, 47 and 10.5 in 37°47'10.5".
+var numberRE = regexp.MustCompile(`\d+(?:\.\d+)?`)
+
+// FromV2 converts an xAL 2.0 address to an xAL 3.0 address.
+//
+// The components of the flat address, see xal.Flatten, are converted to the address parts holding them,
+// eg. street_name to the NameOnly name element of the Thoroughfare. The house number is the Number of
+// the Thoroughfare of addresses with a street, and of the Premises otherwise. Address lines keep their type
+// in the FreeTextAddress, the first address identifier gives AttrAddressID, a District-typed administrative
+// area name the SubAdministrativeArea, the scheme of the country name code the AttrNameCodeType of the
+// country name element, and the latitude and longitude of the postal service elements the LocationByCoordinates.
+//
+// The report lists the values of the xAL 2.0 address that were not converted, as JSON Pointers,
+// eg. the large mail user that xAL 3.0 has no part for, and what Flatten leaves out.
+func FromV2(a *xal.AddressDetails) (*Address, xal.Report) {
+	if a == nil {
+		return nil, xal.Report{}
+	}
+	flat, r := a.Flatten()
+	addr := &Address{
+		AttrType:          flat[xal.ComponentAddressType],
+		AttrUsage:         flat[xal.ComponentUsage],
+		AttrStatus:        flat[xal.ComponentCurrentStatus],
+		AttrDateValidFrom: flat[xal.ComponentValidFrom],
+		AttrDateValidTo:   flat[xal.ComponentValidTo],
+	}
+	carried := map[string]bool{}
+	var lost []string
+	var scheme string
+	xal.Walk(a, func(path xal.Path, node any) error {
+		switch n := node.(type) {
+		case *xal.CountryNameCode:
+			if n.AttrScheme != "" && n.Text != "" && n.Text == flat[xal.ComponentCountryCode] {
+				scheme = n.AttrScheme
+				carried[at(path, "attr_scheme").String()] = true
+			}
+		case *xal.AddressLine:
+			if n.Text != "" && n.AttrType != "" {
+				carried[at(path, "attr_type").String()] = true
+			}
+		case *xal.AdministrativeAreaName:
+			if strings.EqualFold(n.AttrType, "District") && n.Text != "" && n.Text != flat[xal.ComponentAdminArea] && addr.AdministrativeArea == nil {
+				addr.AdministrativeArea = &AdministrativeArea{SubAdministrativeArea: &SubAdministrativeArea{
+					AttrType:    n.AttrType,
+					NameElement: []*NameElement{{Text: n.Text}},
+				}}
+				carried[at(path, "attr_type").String()] = true
+				carried[at(path, "text").String()] = true
+			}
+		case *xal.AddressIdentifier:
+			if n.Text != "" && addr.AttrAddressID == "" {
+				addr.AttrAddressID, addr.AttrAddressIDType = n.Text, n.AttrIdentifierType
+				carried[at(path, "text").String()] = true
+				carried[at(path, "attr_identifier_type").String()] = true
+			}
+		case *xal.LargeMailUserName:
+			if n.Text != "" && n.Text == flat[xal.ComponentLargeMailUser] {
+				lost = append(lost, at(path, "text").String())
+			}
+		}
+		return nil
+	})
+	if a.AddressLines != nil {
+		for _, l := range *a.AddressLines {
+			if l != nil && l.Text != "" {
+				if addr.FreeTextAddress == nil {
+					addr.FreeTextAddress = &FreeTextAddress{}
+				}
+				addr.FreeTextAddress.AddressLine = append(addr.FreeTextAddress.AddressLine, &AddressLine{AttrType: l.AttrType, Text: l.Text})
+			}
+		}
+	}
+	if code, name := flat[xal.ComponentCountryCode], flat[xal.ComponentCountryName]; code != "" || name != "" {
+		addr.Country = &Country{NameElement: []*NameElement{{AttrNameCode: code, AttrNameCodeType: scheme, Text: name}}}
+	}
+	if name, typ := flat[xal.ComponentAdminArea], flat[xal.ComponentAdminAreaType]; name != "" || typ != "" {
+		if addr.AdministrativeArea == nil {
+			addr.AdministrativeArea = &AdministrativeArea{}
+		}
+		addr.AdministrativeArea.AttrType = typ
+		addr.AdministrativeArea.NameElement = names(NameTypeName, name)
+	}
+	if name, typ := flat[xal.ComponentLocality], flat[xal.ComponentLocalityType]; name != "" || typ != "" {
+		addr.Locality = &Locality{AttrType: typ, NameElement: names(NameTypeName, name)}
+	}
+	if dl := names(NameTypeName, flat[xal.ComponentDependentLocality], NameTypeNumber, flat[xal.ComponentDependentLocalityNumber]); dl != nil {
+		if addr.Locality == nil {
+			addr.Locality = &Locality{}
+		}
+		addr.Locality.SubLocality = &SubLocality{NameElement: dl}
+	}
+	number := numbers(NumberTypeNumber, flat[xal.ComponentHouseNumber], NumberTypeSuffix, flat[xal.ComponentHouseNumberSuffix])
+	street := names(
+		NameTypePreDirection, flat[xal.ComponentStreetPreDirection],
+		NameTypeType, flat[xal.ComponentStreetLeadingType],
+		NameTypeNameOnly, flat[xal.ComponentStreetName],
+		NameTypeType, flat[xal.ComponentStreetType],
+		NameTypePostDirection, flat[xal.ComponentStreetPostDirection],
+	)
+	if street != nil {
+		addr.Thoroughfare = &Thoroughfare{NameElement: street, Number: number}
+		number = nil
+		if dependent := names(NameTypeNameOnly, flat[xal.ComponentDependentStreetName]); dependent != nil {
+			addr.Thoroughfare.SubThoroughfare = []*SubThoroughfare{{NameElement: dependent}}
+		}
+	}
+	premise := names(NameTypeName, flat[xal.ComponentPremiseName], NameTypeBuilding, flat[xal.ComponentBuildingName])
+	var sub []*SubPremises
+	if typ, name, unit := flat[xal.ComponentUnitType], flat[xal.ComponentUnitName], flat[xal.ComponentUnit]; typ != "" || name != "" || unit != "" {
+		sub = []*SubPremises{{AttrType: typ, NameElement: names(NameTypeName, name), Number: numbers(NumberTypeNumber, unit)}}
+	}
+	if premise != nil || number != nil || sub != nil {
+		addr.Premises = &Premises{NameElement: premise, Number: number, SubPremises: sub}
+	}
+	if ids := identifiers(
+		IdentifierTypeIdentifier, flat[xal.ComponentPostalCode],
+		IdentifierTypeExtension, flat[xal.ComponentPostalCodeExtension],
+		IdentifierTypeSortingCode, flat[xal.ComponentSortingCode],
+	); ids != nil {
+		addr.PostCode = &PostCode{Identifier: ids}
+	}
+	if typ, box := flat[xal.ComponentPostBoxType], flat[xal.ComponentPostBox]; typ != "" || box != "" {
+		addr.PostalDeliveryPoint = &PostalDeliveryPoint{AttrType: typ, Identifier: identifiers(IdentifierTypeIdentifier, box)}
+	}
+	if name := flat[xal.ComponentPostOffice]; name != "" {
+		addr.PostOffice = &PostOffice{NameElement: names(NameTypeName, name)}
+	}
+	if p := a.PostalServiceElements; p != nil {
+		base := xal.Path{"postal_service_elements"}
+		var lat, latDir, long, longDir string
+		if p.AddressLatitude != nil {
+			lat = p.AddressLatitude.Text
+		}
+		if p.AddressLatitudeDirection != nil {
+			latDir = p.AddressLatitudeDirection.Text
+		}
+		if p.AddressLongitude != nil {
+			long = p.AddressLongitude.Text
+		}
+		if p.AddressLongitudeDirection != nil {
+			longDir = p.AddressLongitudeDirection.Text
+		}
+		latitude, ok1 := coordinate(lat, latDir, "N", "S")
+		longitude, ok2 := coordinate(long, longDir, "E", "W")
+		if ok1 || ok2 {
+			addr.LocationByCoordinates = &LocationByCoordinates{Latitude: latitude, Longitude: longitude}
+		}
+		if ok1 {
+			carried[at(base, "address_latitude", "text").String()] = true
+			carried[at(base, "address_latitude_direction", "text").String()] = true
+		}
+		if ok2 {
+			carried[at(base, "address_longitude", "text").String()] = true
+			carried[at(base, "address_longitude_direction", "text").String()] = true
+		}
+	}
+	dropped := lost
+	for _, p := range r.Dropped {
+		if !carried[p] {
+			dropped = append(dropped, p)
+		}
+	}
+	sort.Strings(dropped)
+	return addr, xal.Report{Dropped: dropped}
+}
+
+// ToV2 converts an xAL 3.0 address to an xAL 2.0 address, see FromV2.
+//
+// The values of each part are read by their type, eg. the NameOnly name element of the Thoroughfare
+// as the street name, and a Type name element before it as the leading type. Name elements, numbers
+// and identifiers of no type are read as the name, the number and the identifier of their part.
+// The first SubThoroughfare gives the dependent thoroughfare and the first SubPremises the sub-premise.
+// A GeoRSS point gives the latitude and longitude of addresses without LocationByCoordinates.
+// The nodes are created in xal.BranchCountry, see xal.Unflatten.
+//
+// The report lists the values of the xAL 3.0 address that were not converted, as JSON Pointers,
+// eg. /rural_delivery, or /thoroughfare/name_element/3 for a second street name.
+func ToV2(addr *Address) (*xal.AddressDetails, xal.Report) {
+	if addr == nil {
+		return nil, xal.Report{}
+	}
+	c := &converter{flat: xal.Flat{}}
+	var scheme string
+	c.set(xal.ComponentAddressType, addr.AttrType, xal.Path{"attr_type"})
+	c.set(xal.ComponentUsage, addr.AttrUsage, xal.Path{"attr_usage"})
+	c.set(xal.ComponentCurrentStatus, addr.AttrStatus, xal.Path{"attr_status"})
+	c.set(xal.ComponentValidFrom, addr.AttrDateValidFrom, xal.Path{"attr_date_valid_from"})
+	c.set(xal.ComponentValidTo, addr.AttrDateValidTo, xal.Path{"attr_date_valid_to"})
+	c.drop(addr.AttrAddressIDType != "" && addr.AttrAddressID == "", xal.Path{"attr_address_id_type"})
+	c.drop(addr.AttrID != "", xal.Path{"attr_id"})
+	c.drop(addr.AttrDeliveryMode != "", xal.Path{"attr_delivery_mode"})
+	c.drop(addr.AttrLanguageCode != "", xal.Path{"attr_language_code"})
+	c.drop(addr.RuralDelivery != nil, xal.Path{"rural_delivery"})
+
+	if co := addr.Country; co != nil {
+		base := xal.Path{"country", "name_element"}
+		for i, n := range co.NameElement {
+			if n == nil {
+				continue
+			}
+			p := at(base, strconv.Itoa(i))
+			if i > 0 {
+				c.drop(n.AttrNameCode != "" || n.Text != "", p)
+				continue
+			}
+			c.set(xal.ComponentCountryCode, n.AttrNameCode, at(p, "attr_name_code"))
+			c.set(xal.ComponentCountryName, n.Text, at(p, "text"))
+			c.drop(n.AttrNameCodeType != "" && n.AttrNameCode == "", at(p, "attr_name_code_type"))
+			if n.AttrNameCode != "" {
+				scheme = n.AttrNameCodeType
+			}
+		}
+	}
+	var district string
+	if aa := addr.AdministrativeArea; aa != nil {
+		c.set(xal.ComponentAdminAreaType, aa.AttrType, xal.Path{"administrative_area", "attr_type"})
+		c.names(aa.NameElement, xal.Path{"administrative_area", "name_element"}, map[string]xal.Component{
+			NameTypeName: xal.ComponentAdminArea,
+		})
+		if sa := aa.SubAdministrativeArea; sa != nil {
+			base := xal.Path{"administrative_area", "sub_administrative_area"}
+			for i, n := range sa.NameElement {
+				switch {
+				case n == nil || n.Text == "":
+				case district == "" && (n.AttrNameType == "" || n.AttrNameType == NameTypeName):
+					district = n.Text
+				default:
+					c.drop(true, at(base, "name_element", strconv.Itoa(i)))
+				}
+			}
+			c.drop(district != "" && sa.AttrType != "" && !strings.EqualFold(sa.AttrType, "District"), at(base, "attr_type"))
+		}
+	}
+	if l := addr.Locality; l != nil {
+		c.set(xal.ComponentLocalityType, l.AttrType, xal.Path{"locality", "attr_type"})
+		c.names(l.NameElement, xal.Path{"locality", "name_element"}, map[string]xal.Component{
+			NameTypeName: xal.ComponentLocality,
+		})
+		if sl := l.SubLocality; sl != nil {
+			c.drop(sl.AttrType != "", xal.Path{"locality", "sub_locality", "attr_type"})
+			c.names(sl.NameElement, xal.Path{"locality", "sub_locality", "name_element"}, map[string]xal.Component{
+				NameTypeName:   xal.ComponentDependentLocality,
+				NameTypeNumber: xal.ComponentDependentLocalityNumber,
+			})
+		}
+	}
+	if t := addr.Thoroughfare; t != nil {
+		base := xal.Path{"thoroughfare"}
+		c.drop(t.AttrType != "", at(base, "attr_type"))
+		leading := true
+		for i, n := range t.NameElement {
+			if n == nil {
+				continue
+			}
+			p := at(base, "name_element", strconv.Itoa(i))
+			switch n.AttrNameType {
+			case "", NameTypeNameOnly, NameTypeName:
+				c.set(xal.ComponentStreetName, n.Text, at(p, "text"))
+				leading = false
+			case NameTypeType:
+				if leading && c.flat[xal.ComponentStreetName] == "" {
+					c.set(xal.ComponentStreetLeadingType, n.Text, at(p, "text"))
+				} else {
+					c.set(xal.ComponentStreetType, n.Text, at(p, "text"))
+				}
+			case NameTypePreDirection:
+				c.set(xal.ComponentStreetPreDirection, n.Text, at(p, "text"))
+			case NameTypePostDirection:
+				c.set(xal.ComponentStreetPostDirection, n.Text, at(p, "text"))
+			default:
+				c.drop(n.Text != "", p)
+			}
+		}
+		c.numbers(t.Number, at(base, "number"))
+		for i, st := range t.SubThoroughfare {
+			if st == nil {
+				continue
+			}
+			p := at(base, "sub_thoroughfare", strconv.Itoa(i))
+			if i > 0 {
+				c.drop(true, p)
+				continue
+			}
+			c.drop(st.AttrType != "", at(p, "attr_type"))
+			c.drop(len(st.Number) > 0, at(p, "number"))
+			c.names(st.NameElement, at(p, "name_element"), map[string]xal.Component{
+				NameTypeNameOnly: xal.ComponentDependentStreetName,
+				NameTypeName:     xal.ComponentDependentStreetName,
+			})
+		}
+	}
+	if pr := addr.Premises; pr != nil {
+		base := xal.Path{"premises"}
+		c.drop(pr.AttrType != "", at(base, "attr_type"))
+		c.names(pr.NameElement, at(base, "name_element"), map[string]xal.Component{
+			NameTypeName:     xal.ComponentPremiseName,
+			NameTypeBuilding: xal.ComponentBuildingName,
+		})
+		c.numbers(pr.Number, at(base, "number"))
+		for i, sp := range pr.SubPremises {
+			if sp == nil {
+				continue
+			}
+			p := at(base, "sub_premises", strconv.Itoa(i))
+			if i > 0 {
+				c.drop(true, p)
+				continue
+			}
+			c.set(xal.ComponentUnitType, sp.AttrType, at(p, "attr_type"))
+			c.names(sp.NameElement, at(p, "name_element"), map[string]xal.Component{
+				NameTypeName: xal.ComponentUnitName,
+			})
+			for j, n := range sp.Number {
+				if n != nil && n.Text != "" {
+					q := at(p, "number", strconv.Itoa(j))
+					if n.AttrType == "" || n.AttrType == NumberTypeNumber {
+						c.set(xal.ComponentUnit, n.Text, at(q, "text"))
+					} else {
+						c.drop(true, q)
+					}
+				}
+			}
+		}
+	}
+	if pc := addr.PostCode; pc != nil {
+		c.identifiers(pc.Identifier, xal.Path{"post_code", "identifier"}, map[string]xal.Component{
+			IdentifierTypeIdentifier:  xal.ComponentPostalCode,
+			IdentifierTypeExtension:   xal.ComponentPostalCodeExtension,
+			IdentifierTypeSortingCode: xal.ComponentSortingCode,
+		})
+	}
+	if pd := addr.PostalDeliveryPoint; pd != nil {
+		c.set(xal.ComponentPostBoxType, pd.AttrType, xal.Path{"postal_delivery_point", "attr_type"})
+		c.identifiers(pd.Identifier, xal.Path{"postal_delivery_point", "identifier"}, map[string]xal.Component{
+			IdentifierTypeIdentifier: xal.ComponentPostBox,
+		})
+	}
+	if po := addr.PostOffice; po != nil {
+		c.drop(po.AttrType != "", xal.Path{"post_office", "attr_type"})
+		c.drop(len(po.Identifier) > 0, xal.Path{"post_office", "identifier"})
+		c.names(po.NameElement, xal.Path{"post_office", "name_element"}, map[string]xal.Component{
+			NameTypeName: xal.ComponentPostOffice,
+		})
+	}
+
+	// Every component set above is part of the flat vocabulary.
+	a, _ := xal.Unflatten(c.flat)
+	if scheme != "" && a.Country != nil && len(a.Country.CountryNameCode) > 0 {
+		a.Country.CountryNameCode[0].AttrScheme = scheme
+	}
+	if ft := addr.FreeTextAddress; ft != nil {
+		var lines xal.AddressLines
+		for _, l := range ft.AddressLine {
+			if l != nil && strings.TrimSpace(l.Text) != "" {
+				lines = append(lines, &xal.AddressLine{AttrType: l.AttrType, Text: strings.TrimSpace(l.Text)})
+			}
+		}
+		if len(lines) > 0 {
+			a.AddressLines = &lines
+		}
+	}
+	if district != "" {
+		if a.Country == nil {
+			a.Country = &xal.Country{}
+		}
+		if a.Country.AdministrativeArea == nil {
+			a.Country.AdministrativeArea = &xal.AdministrativeArea{}
+		}
+		aa := a.Country.AdministrativeArea
+		aa.AdministrativeAreaName = append(aa.AdministrativeAreaName, &xal.AdministrativeAreaName{AttrType: "District", Text: district})
+	}
+	postal := func() *xal.PostalServiceElements {
+		if a.PostalServiceElements == nil {
+			a.PostalServiceElements = &xal.PostalServiceElements{}
+		}
+		return a.PostalServiceElements
+	}
+	if addr.AttrAddressID != "" {
+		postal().AddressIdentifier = []*xal.AddressIdentifier{{AttrIdentifierType: addr.AttrAddressIDType, Text: addr.AttrAddressID}}
+	}
+	switch loc := addr.LocationByCoordinates; {
+	case loc != nil:
+		base := xal.Path{"location_by_coordinates"}
+		c.drop(loc.AttrMeridian != "", at(base, "attr_meridian"))
+		c.drop(loc.AttrDatum != "", at(base, "attr_datum"))
+		c.drop(loc.AttrProjection != "", at(base, "attr_projection"))
+		if text, dir := loc.Latitude.text(); text != "" {
+			postal().AddressLatitude = &xal.AddressLatitude{Text: text}
+			if dir != "" {
+				postal().AddressLatitudeDirection = &xal.AddressLatitudeDirection{Text: dir}
+			}
+		}
+		if text, dir := loc.Longitude.text(); text != "" {
+			postal().AddressLongitude = &xal.AddressLongitude{Text: text}
+			if dir != "" {
+				postal().AddressLongitudeDirection = &xal.AddressLongitudeDirection{Text: dir}
+			}
+		}
+		c.drop(addr.GeoRSS != nil && addr.GeoRSS.Point != "", xal.Path{"geo_rss", "point"})
+	case addr.GeoRSS != nil && addr.GeoRSS.Point != "":
+		fields := strings.Fields(addr.GeoRSS.Point)
+		if len(fields) != 2 {
+			c.drop(true, xal.Path{"geo_rss", "point"})
+			break
+		}
+		lat, err1 := strconv.ParseFloat(fields[0], 64)
+		long, err2 := strconv.ParseFloat(fields[1], 64)
+		if err1 != nil || err2 != nil {
+			c.drop(true, xal.Path{"geo_rss", "point"})
+			break
+		}
+		p := postal()
+		p.AddressLatitude = &xal.AddressLatitude{Text: strings.TrimPrefix(fields[0], "-")}
+		p.AddressLatitudeDirection = &xal.AddressLatitudeDirection{Text: direction(lat, "N", "S")}
+		p.AddressLongitude = &xal.AddressLongitude{Text: strings.TrimPrefix(fields[1], "-")}
+		p.AddressLongitudeDirection = &xal.AddressLongitudeDirection{Text: direction(long, "E", "W")}
+	}
+	sort.Strings(c.dropped)
+	return a, xal.Report{Dropped: c.dropped}
+}
+
+// converter collects the components of an xAL 3.0 address and the values it cannot carry.
+type converter struct {
+	flat    xal.Flat
+	dropped []string
+}
+
+// set sets the component, or reports the value at p when the component is already set.
+func (c *converter) set(comp xal.Component, v string, p xal.Path) {
+	v = strings.TrimSpace(v)
+	switch {
+	case v == "":
+	case c.flat[comp] == "":
+		c.flat[comp] = v
+	default:
+		c.dropped = append(c.dropped, p.String())
+	}
+}
+
+// drop reports the value at p when lost is true.
+func (c *converter) drop(lost bool, p xal.Path) {
+	if lost {
+		c.dropped = append(c.dropped, p.String())
+	}
+}
+
+// names sets the components of the name elements by their name type, those of no type
+// setting the component of NameTypeName, and reports the elements of other types.
+func (c *converter) names(elements []*NameElement, base xal.Path, components map[string]xal.Component) {
+	for i, n := range elements {
+		if n == nil || n.Text == "" {
+			continue
+		}
+		typ := n.AttrNameType
+		if typ == "" {
+			typ = NameTypeName
+		}
+		p := at(base, strconv.Itoa(i))
+		if comp, ok := components[typ]; ok {
+			c.set(comp, n.Text, at(p, "text"))
+		} else {
+			c.drop(true, p)
+		}
+	}
+}
+
+// numbers sets the house number and its suffix from the numbers, and reports numbers of other types.
+func (c *converter) numbers(numbers []*Number, base xal.Path) {
+	for i, n := range numbers {
+		if n == nil || n.Text == "" {
+			continue
+		}
+		p := at(base, strconv.Itoa(i))
+		switch n.AttrType {
+		case "", NumberTypeNumber:
+			c.set(xal.ComponentHouseNumber, n.Text, at(p, "text"))
+		case NumberTypeSuffix:
+			c.set(xal.ComponentHouseNumberSuffix, n.Text, at(p, "text"))
+		default:
+			c.drop(true, p)
+		}
+	}
+}
+
+// identifiers sets the components of the identifiers by their type, those of no type
+// setting the component of IdentifierTypeIdentifier, and reports the identifiers of other types.
+func (c *converter) identifiers(ids []*Identifier, base xal.Path, components map[string]xal.Component) {
+	for i, id := range ids {
+		if id == nil || id.Text == "" {
+			continue
+		}
+		typ := id.AttrType
+		if typ == "" {
+			typ = IdentifierTypeIdentifier
+		}
+		p := at(base, strconv.Itoa(i))
+		if comp, ok := components[typ]; ok {
+			c.set(comp, id.Text, at(p, "text"))
+		} else {
+			c.drop(true, p)
+		}
+	}
+}
+
+// text returns the coordinate as degrees, minutes and seconds, eg. 37°47'10.5", and its direction.
+func (co *Coordinate) text() (text, dir string) {
+	if co == nil || co.AttrDegreesMeasure == "" {
+		return "", ""
+	}
+	text = co.AttrDegreesMeasure + "°"
+	if co.AttrMinutesMeasure != "" {
+		text += co.AttrMinutesMeasure + "'"
+	}
+	if co.AttrSecondsMeasure != "" {
+		text += co.AttrSecondsMeasure + `"`
+	}
+	return text, co.AttrDirection
+}
+
+// coordinate parses the degrees, and optional minutes and seconds, of an xAL 2.0 latitude or longitude,
+// eg. 37°47'10.5" or 37.786, with dir or the sign of v giving the direction.
+func coordinate(v, dir, positive, negative string) (*Coordinate, bool) {
+	v = strings.TrimSpace(v)
+	nums := numberRE.FindAllString(v, -1)
+	if len(nums) == 0 || len(nums) > 3 {
+		return nil, false
+	}
+	co := &Coordinate{AttrDegreesMeasure: nums[0]}
+	if len(nums) > 1 {
+		co.AttrMinutesMeasure = nums[1]
+	}
+	if len(nums) > 2 {
+		co.AttrSecondsMeasure = nums[2]
+	}
+	switch dir = strings.ToUpper(strings.TrimSpace(dir)); {
+	case dir != "":
+		co.AttrDirection = dir[:1]
+	case strings.HasPrefix(v, "-"):
+		co.AttrDirection = negative
+	default:
+		co.AttrDirection = positive
+	}
+	return co, true
+}
+
+// direction returns positive or negative by the sign of v.
+func direction(v float64, positive, negative string) string {
+	if v < 0 {
+		return negative
+	}
+	return positive
+}
+
+// names returns the name elements of the non-empty values of typ, value pairs, or nil if there are none.
+func names(pairs ...string) []*NameElement {
+	var out []*NameElement
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] != "" {
+			out = append(out, &NameElement{AttrNameType: pairs[i], Text: pairs[i+1]})
+		}
+	}
+	return out
+}
+
+// numbers returns the numbers of the non-empty values of typ, value pairs, or nil if there are none.
+func numbers(pairs ...string) []*Number {
+	var out []*Number
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] != "" {
+			out = append(out, &Number{AttrType: pairs[i], Text: pairs[i+1]})
+		}
+	}
+	return out
+}
+
+// identifiers returns the identifiers of the non-empty values of typ, value pairs, or nil if there are none.
+func identifiers(pairs ...string) []*Identifier {
+	var out []*Identifier
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] != "" {
+			out = append(out, &Identifier{AttrType: pairs[i], Text: pairs[i+1]})
+		}
+	}
+	return out
+}
+
+// at returns p extended with elems, without sharing the backing array of p.
+func at(p xal.Path, elems ...string) xal.Path {
+	return append(slices.Clip(p), elems...)
+}
@@ -0,0 +1,197 @@
+// Package xal3 holds the model of OASIS CIQ xAL 3.0 addresses, and converters from and to
+// the xAL 2.0 model of package xal, see FromV2 and ToV2.
+//
+// xAL 3.0 replaces the nested, element per concept tree of xAL 2.0 with a flat list of address parts,
+// each made of typed NameElement, Number or Identifier values, eg. a Thoroughfare
+// with the name elements PreDirection N, NameOnly Main and Type St, and the number 12.
+package xal3
+
+type (
+	// Address - Root element of an xAL 3.0 address.
+	Address struct {
+		AttrType              string                 `json:"attr_type,omitempty"`       // Type of address, eg. Business, Residential
+		AttrAddressID         string                 `json:"attr_address_id,omitempty"` // Identifier of the address, eg. in a register
+		AttrAddressIDType     string                 `json:"attr_address_id_type,omitempty"`
+		AttrID                string                 `json:"attr_id,omitempty"`            // Identifier of the element in the document
+		AttrUsage             string                 `json:"attr_usage,omitempty"`         // eg. Home, Business, Billing
+		AttrDeliveryMode      string                 `json:"attr_delivery_mode,omitempty"` // eg. Post box, Mail box
+		AttrStatus            string                 `json:"attr_status,omitempty"`        // eg. Current, Old
+		AttrDateValidFrom     string                 `json:"attr_date_valid_from,omitempty"`
+		AttrDateValidTo       string                 `json:"attr_date_valid_to,omitempty"`
+		AttrLanguageCode      string                 `json:"attr_language_code,omitempty"`
+		FreeTextAddress       *FreeTextAddress       `json:"free_text_address,omitempty"`
+		Country               *Country               `json:"country,omitempty"`
+		AdministrativeArea    *AdministrativeArea    `json:"administrative_area,omitempty"`
+		Locality              *Locality              `json:"locality,omitempty"`
+		Thoroughfare          *Thoroughfare          `json:"thoroughfare,omitempty"`
+		Premises              *Premises              `json:"premises,omitempty"`
+		PostCode              *PostCode              `json:"post_code,omitempty"`
+		RuralDelivery         *RuralDelivery         `json:"rural_delivery,omitempty"`
+		PostalDeliveryPoint   *PostalDeliveryPoint   `json:"postal_delivery_point,omitempty"`
+		PostOffice            *PostOffice            `json:"post_office,omitempty"`
+		GeoRSS                *GeoRSS                `json:"geo_rss,omitempty"`
+		LocationByCoordinates *LocationByCoordinates `json:"location_by_coordinates,omitempty"`
+	}
+
+	// FreeTextAddress - The address as lines of free text, as printed on an envelope.
+	FreeTextAddress struct {
+		AddressLine []*AddressLine `json:"address_line,omitempty"`
+	}
+
+	// AddressLine - A line of free text of the address.
+	AddressLine struct {
+		AttrType string `json:"attr_type,omitempty"` // Type of the line, eg. Country
+		Text     string `json:"text,omitempty"`
+	}
+
+	// NameElement - A name, or a part of a name, of an address part, eg. the NameOnly Main of a thoroughfare.
+	NameElement struct {
+		AttrNameType     string `json:"attr_name_type,omitempty"`      // Semantics of the name, see the NameType constants
+		AttrAbbreviation string `json:"attr_abbreviation,omitempty"`   // true when the text is an abbreviation, eg. St
+		AttrNameCode     string `json:"attr_name_code,omitempty"`      // Code of the name, eg. the ISO 3166-1 code of a country
+		AttrNameCodeType string `json:"attr_name_code_type,omitempty"` // Scheme of the code, eg. ISO 3166-1 alpha-2
+		Text             string `json:"text,omitempty"`
+	}
+
+	// Number - A number, or a part of a number, of an address part, eg. the Number 12 of a thoroughfare.
+	Number struct {
+		AttrType string `json:"attr_type,omitempty"` // Semantics of the number, see the NumberType constants
+		Text     string `json:"text,omitempty"`
+	}
+
+	// Identifier - An identifier of an address part, eg. the number of a post box or a post code.
+	Identifier struct {
+		AttrType string `json:"attr_type,omitempty"` // Semantics of the identifier, see the IdentifierType constants
+		Text     string `json:"text,omitempty"`
+	}
+
+	// Country - The country of the address.
+	Country struct {
+		NameElement []*NameElement `json:"name_element,omitempty"`
+	}
+
+	// AdministrativeArea - The top level administrative division of the country, eg. a state, a province or a region.
+	AdministrativeArea struct {
+		AttrType              string                 `json:"attr_type,omitempty"` // eg. State, Province, Region
+		NameElement           []*NameElement         `json:"name_element,omitempty"`
+		SubAdministrativeArea *SubAdministrativeArea `json:"sub_administrative_area,omitempty"`
+	}
+
+	// SubAdministrativeArea - A division of the administrative area, eg. a county or a district.
+	SubAdministrativeArea struct {
+		AttrType    string         `json:"attr_type,omitempty"` // eg. County, District
+		NameElement []*NameElement `json:"name_element,omitempty"`
+	}
+
+	// Locality - The locality of the address, eg. a city, a town or a village.
+	Locality struct {
+		AttrType    string         `json:"attr_type,omitempty"` // eg. City, Town, Village
+		NameElement []*NameElement `json:"name_element,omitempty"`
+		SubLocality *SubLocality   `json:"sub_locality,omitempty"`
+	}
+
+	// SubLocality - A division of the locality, eg. a suburb or a district.
+	SubLocality struct {
+		AttrType    string         `json:"attr_type,omitempty"` // eg. Suburb, District
+		NameElement []*NameElement `json:"name_element,omitempty"`
+	}
+
+	// Thoroughfare - The road, street or path of the address, with the number on it.
+	Thoroughfare struct {
+		AttrType        string             `json:"attr_type,omitempty"` // eg. Street, Road, Canal
+		NameElement     []*NameElement     `json:"name_element,omitempty"`
+		Number          []*Number          `json:"number,omitempty"`
+		SubThoroughfare []*SubThoroughfare `json:"sub_thoroughfare,omitempty"` // Thoroughfares the thoroughfare is on, or crosses
+	}
+
+	// SubThoroughfare - A thoroughfare that the thoroughfare of the address depends on.
+	SubThoroughfare struct {
+		AttrType    string         `json:"attr_type,omitempty"`
+		NameElement []*NameElement `json:"name_element,omitempty"`
+		Number      []*Number      `json:"number,omitempty"`
+	}
+
+	// Premises - The building, or the land, of the address.
+	Premises struct {
+		AttrType    string         `json:"attr_type,omitempty"` // eg. Building, Farm, Airport
+		NameElement []*NameElement `json:"name_element,omitempty"`
+		Number      []*Number      `json:"number,omitempty"`
+		SubPremises []*SubPremises `json:"sub_premises,omitempty"` // From the largest to the smallest, eg. a floor then a flat
+	}
+
+	// SubPremises - A division of the premises, eg. a flat, a floor or a suite.
+	SubPremises struct {
+		AttrType    string         `json:"attr_type,omitempty"` // eg. Flat, Floor, Suite
+		NameElement []*NameElement `json:"name_element,omitempty"`
+		Number      []*Number      `json:"number,omitempty"`
+	}
+
+	// PostCode - The post code of the address.
+	PostCode struct {
+		Identifier []*Identifier `json:"identifier,omitempty"`
+	}
+
+	// RuralDelivery - A rural delivery route, eg. RD 7 in New Zealand.
+	RuralDelivery struct {
+		Identifier []*Identifier `json:"identifier,omitempty"`
+	}
+
+	// PostalDeliveryPoint - A delivery point of a postal service, eg. a post box or a locked bag.
+	PostalDeliveryPoint struct {
+		AttrType   string        `json:"attr_type,omitempty"` // eg. POBox, Locked Bag
+		Identifier []*Identifier `json:"identifier,omitempty"`
+	}
+
+	// PostOffice - The post office the address is delivered from.
+	PostOffice struct {
+		AttrType    string         `json:"attr_type,omitempty"`
+		NameElement []*NameElement `json:"name_element,omitempty"`
+		Identifier  []*Identifier  `json:"identifier,omitempty"`
+	}
+
+	// GeoRSS - The location of the address as a GeoRSS simple geometry.
+	GeoRSS struct {
+		Point string `json:"point,omitempty"` // Latitude and longitude in decimal degrees, eg. 45.256 -71.92
+	}
+
+	// LocationByCoordinates - The location of the address in degrees, minutes and seconds.
+	LocationByCoordinates struct {
+		AttrMeridian   string      `json:"attr_meridian,omitempty"`
+		AttrDatum      string      `json:"attr_datum,omitempty"` // eg. WGS84
+		AttrProjection string      `json:"attr_projection,omitempty"`
+		Latitude       *Coordinate `json:"latitude,omitempty"`
+		Longitude      *Coordinate `json:"longitude,omitempty"`
+	}
+
+	// Coordinate - A latitude or a longitude.
+	Coordinate struct {
+		AttrDegreesMeasure string `json:"attr_degrees_measure,omitempty"`
+		AttrMinutesMeasure string `json:"attr_minutes_measure,omitempty"`
+		AttrSecondsMeasure string `json:"attr_seconds_measure,omitempty"`
+		AttrDirection      string `json:"attr_direction,omitempty"` // N or S for a latitude, E or W for a longitude
+	}
+)
+
+// NameType values of the name elements written and read by the converters.
+const (
+	NameTypeName          = "Name"          // The name of the part, eg. of a locality or a premises
+	NameTypeNumber        = "Number"        // A name that is a number, eg. of a sub-locality
+	NameTypeNameOnly      = "NameOnly"      // The name of a thoroughfare, without its type or directions
+	NameTypeType          = "Type"          // The type of a thoroughfare, eg. St, before the name for a leading type
+	NameTypePreDirection  = "PreDirection"  // eg. the N of N Main St
+	NameTypePostDirection = "PostDirection" // eg. the NW of Main St NW
+	NameTypeBuilding      = "Building"      // The name of the building of a premises
+)
+
+// NumberType values of the numbers written and read by the converters.
+const (
+	NumberTypeNumber = "Number"
+	NumberTypeSuffix = "Suffix" // eg. the A of 12A
+)
+
+// IdentifierType values of the identifiers written and read by the converters.
+const (
+	IdentifierTypeIdentifier  = "Identifier"
+	IdentifierTypeExtension   = "Extension"   // eg. the 1234 of the ZIP+4 code 94105-1234
+	IdentifierTypeSortingCode = "SortingCode" // A sorting code of the postal service, eg. a CEDEX office
+)
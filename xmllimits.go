@@ -0,0 +1,194 @@
+package xal
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Errors of the XML documents that exceed their XMLLimits, wrapped by an *XMLLimitError.
+var (
+	ErrXMLTooDeep           = errors.New("xal: XML elements nested too deep")
+	ErrXMLTooManyElements   = errors.New("xal: too many XML elements")
+	ErrXMLTooManyAttributes = errors.New("xal: too many XML attributes")
+	ErrXMLTextTooLong       = errors.New("xal: XML text too long")
+	ErrXMLDTD               = errors.New("xal: XML document type declarations are not allowed")
+)
+
+// XMLLimits - Limits on the XML documents read from untrusted input, eg. elements nested thousands deep
+// through the recursive DependentLocality, Premise and SubPremise, or huge text nodes.
+// A limit of zero or less is no limit.
+type XMLLimits struct {
+	MaxDepth            int  // Maximum nesting of elements, the root element being at depth 1
+	MaxElements         int  // Maximum number of elements of the document, or of each address of NewXMLDecoderLimits
+	MaxDocumentElements int  // Maximum number of elements of the document, whatever the number of addresses
+	MaxAttributes       int  // Maximum number of attributes of an element, namespace declarations included
+	MaxTextLength       int  // Maximum length in bytes of the text between markup, or of the value of an attribute
+	AllowDTD            bool // Accept document type declarations, eg. <!DOCTYPE xAL>, which are rejected otherwise
+}
+
+// DefaultXMLLimits are the limits of NewXMLDecoder, well above those of real addresses.
+var DefaultXMLLimits = XMLLimits{
+	MaxDepth:            64,
+	MaxElements:         10000,
+	MaxDocumentElements: 10000000,
+	MaxAttributes:       64,
+	MaxTextLength:       64 << 10,
+}
+
+// XMLLimitError - Reports the line of an XML document where a limit was exceeded.
+type XMLLimitError struct {
+	Line int // Line of the document, counting from 1
+	Err  error
+}
+
+func (e *XMLLimitError) Error() string {
+	return fmt.Sprintf("%v (line %d)", e.Err, e.Line)
+}
+
+func (e *XMLLimitError) Unwrap() error { return e.Err }
+
+// NewDecoder returns an encoding/xml decoder of r enforcing the limits, for any XML type, eg.
+//
+//	err := DefaultXMLLimits.NewDecoder(r).Decode(&ubl)
+//
+// The decoder is strict: entities other than the predefined ones, which could only be declared in a DTD,
+// are syntax errors. Exceeding a limit is an *XMLLimitError, reported before the rest of the document is read:
+// a text or an attribute value longer than MaxTextLength, or an element with more than MaxAttributes attributes,
+// is rejected while it is read, rather than once buffered.
+func (l XMLLimits) NewDecoder(r io.Reader) *xml.Decoder {
+	return xml.NewTokenDecoder(newXMLLimiter(r, l, false))
+}
+
+// newXMLLimiter returns a limiter of the XML document read from r. With perAddress, MaxElements limits
+// the elements of each AddressDetails of an xAL document rather than those of the document.
+func newXMLLimiter(r io.Reader, l XMLLimits, perAddress bool) *xmlLimiter {
+	if l.MaxTextLength > 0 || l.MaxAttributes > 0 {
+		r = &xmlTextReader{r: r, maxText: l.MaxTextLength, maxAttrs: l.MaxAttributes}
+	}
+	return &xmlLimiter{d: xml.NewDecoder(r), limits: l, perAddress: perAddress}
+}
+
+// xmlLimiter - Reads the tokens of an XML document, checking them against limits.
+type xmlLimiter struct {
+	d          *xml.Decoder
+	limits     XMLLimits
+	perAddress bool // MaxElements applies to each child of an xAL root element
+	list       bool // The root element is an xAL list of addresses
+	depth      int
+	elements   int // Elements of the document, or of the current address with perAddress
+	total      int // Elements of the document
+	text       int // Length of the text read since the last start or end element
+}
+
+func (x *xmlLimiter) Token() (xml.Token, error) {
+	tok, err := x.d.RawToken()
+	if err != nil {
+		return tok, err
+	}
+	l := x.limits
+	switch t := tok.(type) {
+	case xml.StartElement:
+		x.depth++
+		x.text = 0
+		if x.depth == 1 {
+			x.list = t.Name.Local == "xAL"
+		}
+		if x.perAddress && x.list && x.depth == 2 {
+			x.elements = 0
+		}
+		x.elements++
+		x.total++
+		switch {
+		case l.MaxDepth > 0 && x.depth > l.MaxDepth:
+			return nil, x.error(ErrXMLTooDeep, "more than %d levels", l.MaxDepth)
+		case l.MaxElements > 0 && x.elements > l.MaxElements:
+			return nil, x.error(ErrXMLTooManyElements, "more than %d in %s", l.MaxElements, t.Name.Local)
+		case l.MaxDocumentElements > 0 && x.total > l.MaxDocumentElements:
+			return nil, x.error(ErrXMLTooManyElements, "more than %d in the document", l.MaxDocumentElements)
+		case l.MaxAttributes > 0 && len(t.Attr) > l.MaxAttributes:
+			return nil, x.error(ErrXMLTooManyAttributes, "more than %d in %s", l.MaxAttributes, t.Name.Local)
+		}
+		for _, a := range t.Attr {
+			if l.MaxTextLength > 0 && len(a.Value) > l.MaxTextLength {
+				return nil, x.error(ErrXMLTextTooLong, "attribute %s of %s is longer than %d bytes", a.Name.Local, t.Name.Local, l.MaxTextLength)
+			}
+		}
+	case xml.EndElement:
+		x.depth--
+		x.text = 0
+	case xml.CharData:
+		x.text += len(t)
+		if l.MaxTextLength > 0 && x.text > l.MaxTextLength {
+			return nil, x.error(ErrXMLTextTooLong, "longer than %d bytes", l.MaxTextLength)
+		}
+	case xml.Directive:
+		if !l.AllowDTD {
+			return nil, x.error(ErrXMLDTD, "")
+		}
+	}
+	return tok, nil
+}
+
+// error returns an *XMLLimitError at the current line, wrapping err with the details.
+func (x *xmlLimiter) error(err error, format string, args ...any) error {
+	if format != "" {
+		err = fmt.Errorf("%w: "+format, append([]any{err}, args...)...)
+	}
+	line, _ := x.d.InputPos()
+	return &XMLLimitError{Line: line, Err: err}
+}
+
+// xmlTextReader - Reads an XML document, failing once the text since the last markup, or the value of an attribute,
+// is longer than maxText bytes, or once a tag has more than maxAttrs attributes, so that the decoder never buffers more.
+// Comments, processing instructions and declarations count as text. A limit of zero is no limit.
+type xmlTextReader struct {
+	r        io.Reader
+	maxText  int
+	maxAttrs int
+	state    byte // 0 in text, '<' at the start of markup, 't' in a tag, '!' in a comment or a declaration
+	quote    byte // Quote of the attribute value being read in a tag, or 0
+	n        int  // Bytes since the last markup, or in the attribute value
+	attrs    int  // Attribute values of the tag being read
+	line     int  // Line breaks read
+}
+
+func (t *xmlTextReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	for i, b := range p[:n] {
+		if b == '\n' {
+			t.line++
+		}
+		if t.state == '<' {
+			t.state = 't'
+			if b == '!' || b == '?' {
+				t.state = '!'
+			}
+		}
+		switch {
+		case t.state == 0 && b == '<':
+			t.state, t.n, t.attrs = '<', 0, 0
+		case t.state == 't' && t.quote == 0 && (b == '"' || b == '\''):
+			t.quote, t.n = b, 0
+			t.attrs++
+		case t.state == 't' && t.quote == b:
+			t.quote, t.n = 0, 0
+		case t.state != 0 && t.quote == 0 && b == '>':
+			t.state, t.n = 0, 0
+		default:
+			t.n++
+		}
+		var err error
+		switch {
+		case t.maxText > 0 && t.n > t.maxText:
+			err = fmt.Errorf("%w: longer than %d bytes", ErrXMLTextTooLong, t.maxText)
+		case t.maxAttrs > 0 && t.attrs > t.maxAttrs:
+			err = fmt.Errorf("%w: more than %d in an element", ErrXMLTooManyAttributes, t.maxAttrs)
+		}
+		if err != nil {
+			return i, &XMLLimitError{Line: t.line + 1, Err: err}
+		}
+	}
+	return n, err
+}
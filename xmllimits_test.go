@@ -0,0 +1,109 @@
+package xal
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// addresses returns an xAL document of n addresses of m Premise elements each.
+func addresses(n, m int) string {
+	var b strings.Builder
+	b.WriteString(`<xAL xmlns="urn:oasis:names:tc:ciq:xsdschema:xAL:2.0">`)
+	for i := 0; i < n; i++ {
+		b.WriteString("<AddressDetails><Locality>")
+		b.WriteString(strings.Repeat("<Premise/>", m))
+		b.WriteString("</Locality></AddressDetails>")
+	}
+	b.WriteString("</xAL>")
+	return b.String()
+}
+
+func TestXMLLimits(t *testing.T) {
+	limits := XMLLimits{MaxDepth: 8, MaxElements: 10, MaxDocumentElements: 40, MaxAttributes: 3, MaxTextLength: 64}
+	tests := []struct {
+		name    string
+		doc     string
+		perAddr bool // decode with NewXMLDecoderLimits rather than XMLLimits.NewDecoder
+		want    error
+	}{
+		{"within limits", addresses(1, 3), false, nil},
+		{"too deep", strings.Repeat("<Premise>", 9) + strings.Repeat("</Premise>", 9), false, ErrXMLTooDeep},
+		{"too many elements", addresses(3, 3), false, ErrXMLTooManyElements},
+		{"elements of each address", addresses(3, 3), true, nil},
+		{"too many elements in an address", addresses(2, 9), true, ErrXMLTooManyElements},
+		{"elements of a single address", "<AddressDetails>" + strings.Repeat("<Locality/>", 10) + "</AddressDetails>", true, ErrXMLTooManyElements},
+		{"too many elements in the document", addresses(9, 3), true, ErrXMLTooManyElements},
+		{"attributes", `<AddressDetails AddressType="a" Usage="b" Code="c"/>`, false, nil},
+		{"too many attributes", `<AddressDetails AddressType="a" Usage="b" Code="c" CurrentStatus="d"/>`, false, ErrXMLTooManyAttributes},
+		{"quotes in text", `<AddressLine>"a" 'b' "c" 'd'</AddressLine>`, false, nil},
+		{"text too long", "<AddressLine>" + strings.Repeat("x", 65) + "</AddressLine>", false, ErrXMLTextTooLong},
+		{"attribute too long", `<AddressLine Type="` + strings.Repeat("x", 65) + `"/>`, false, ErrXMLTextTooLong},
+		{"DTD", "<!DOCTYPE xAL><xAL/>", false, ErrXMLDTD},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.perAddr {
+				for _, e := range NewXMLDecoderLimits(strings.NewReader(tt.doc), limits).Addresses() {
+					if e != nil {
+						err = e
+					}
+				}
+			} else {
+				var v struct{}
+				err = limits.NewDecoder(strings.NewReader(tt.doc)).Decode(&v)
+			}
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("got error %v", err)
+				}
+				return
+			}
+			var le *XMLLimitError
+			if !errors.As(err, &le) || !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want an *XMLLimitError wrapping %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestXMLLimitsAllowDTD(t *testing.T) {
+	var v struct{}
+	l := XMLLimits{AllowDTD: true}
+	if err := l.NewDecoder(strings.NewReader("<!DOCTYPE xAL><xAL/>")).Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestXMLLimitsWhileReading checks that the attributes and the text are limited before the decoder buffers them:
+// the reader fails if the document is read past the limit.
+func TestXMLLimitsWhileReading(t *testing.T) {
+	limits := XMLLimits{MaxAttributes: 2, MaxTextLength: 64}
+	tests := []struct {
+		name string
+		doc  string
+		want error
+	}{
+		{"attributes", `<AddressDetails a="1" b="2" c="3"`, ErrXMLTooManyAttributes},
+		{"text", "<AddressLine>" + strings.Repeat("x", 65), ErrXMLTextTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := io.MultiReader(strings.NewReader(tt.doc), failingReader{t})
+			var v struct{}
+			err := limits.NewDecoder(r).Decode(&v)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+type failingReader struct{ t *testing.T }
+
+func (r failingReader) Read([]byte) (int, error) {
+	r.t.Error("the document was read past the limit")
+	return 0, io.ErrUnexpectedEOF
+}